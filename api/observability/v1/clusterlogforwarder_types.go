@@ -16,6 +16,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -46,6 +47,17 @@ type ClusterLogForwarderSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Forwarder Inputs"
 	Inputs []InputSpec `json:"inputs,omitempty"`
 
+	// InfrastructureNamespaces are additional namespaces whose container logs are infrastructure logs.
+	// Supports glob patterns (e.g. platform-*) that do not match every namespace.
+	//
+	// Their container logs are collected by infrastructure inputs of the container source and are excluded from
+	// application inputs unless explicitly included.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self.all(n, n.matches('^[a-z0-9*]([-a-z0-9*]*[a-z0-9*])?$') && !n.matches('^[*]+$'))", message="Namespaces must be namespace names or globs that do not match every namespace"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Infrastructure Namespaces"
+	InfrastructureNamespaces []string `json:"infrastructureNamespaces,omitempty"`
+
 	// Outputs are named destinations for log messages.
 	//
 	// +kubebuilder:validation:Required
@@ -122,9 +134,26 @@ type CollectorSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tolerations"
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// ForwarderDiskBufferBudget is the maximum disk space on each node that may be used by the disk buffers
+	// of the outputs of this forwarder.  It does not account for the disk buffers of other forwarders
+	// whose collectors run on the same node.
+	//
+	// Disk buffers are stored in a host path data directory of the collector which is not size limited.
+	// The budget is enforced by validating the maxSize of the disk buffers of the outputs referenced by pipelines.
+	// A failover group counts only the largest disk buffer of its outputs since one output is active at a time.
+	//
+	// Default: 10Gi
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Forwarder Disk Buffer Budget"
+	ForwarderDiskBufferBudget *resource.Quantity `json:"forwarderDiskBufferBudget,omitempty"`
 }
 
 // PipelineSpec links a set of inputs and transformations to a set of outputs.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.outputRefs) || has(self.failover)", message="outputRefs or failover must be spec'd"
 type PipelineSpec struct {
 	// Name of the pipeline
 	//
@@ -149,10 +178,17 @@ type PipelineSpec struct {
 
 	// OutputRefs lists the names (`output.name`) of outputs from this pipeline.
 	//
-	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MinItems:=1
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Outputs"
-	OutputRefs []string `json:"outputRefs"`
+	OutputRefs []string `json:"outputRefs,omitempty"`
+
+	// Failover is an ordered group of outputs of which only one receives the records of this pipeline at a time.
+	// Records are forwarded to the outputs spec'd by OutputRefs and to the active output of the failover group.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Failover"
+	Failover *FailoverSpec `json:"failover,omitempty"`
 
 	// Filters lists the names of filters to be applied to records going through this pipeline.
 	//
@@ -164,6 +200,33 @@ type PipelineSpec struct {
 	FilterRefs []string `json:"filterRefs,omitempty"`
 }
 
+// FailoverSpec defines an ordered group of outputs where the first available output is active
+type FailoverSpec struct {
+	// OutputRefs lists the names (`output.name`) of the outputs of the group in order of preference.
+	//
+	// The first output is the primary output.  The active output fails over to the next output in the list
+	// when it is unavailable longer than the UnavailableDuration, and fails back to a preferred output once
+	// that output has been unavailable for the UnavailableDuration and is available again.
+	// The availability of the active output is the delivery reported by the collector.  The availability of the
+	// other outputs, which is only used to fail back, is determined by the operator connecting to their endpoints
+	// in the background.  Outputs without an endpoint URL are always considered available.
+	// Switching the active output reloads the config of the collector without restarting it.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=2
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Outputs"
+	OutputRefs []string `json:"outputRefs"`
+
+	// UnavailableDuration is the time an output must be unavailable before the group fails over to the next
+	// output (e.g. 90s, 5m, 1h).
+	//
+	// Default: 5m
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Unavailable Duration"
+	UnavailableDuration *metav1.Duration `json:"unavailableDuration,omitempty"`
+}
+
 type LimitSpec struct {
 	// MaxRecordsPerSecond is the maximum number of log records
 	// allowed per input/output in a pipeline
@@ -212,6 +275,21 @@ type SecretReference struct {
 	SecretName string `json:"secretName"`
 }
 
+// ConfigMapReference encodes a reference to a single key in a ConfigMap in the same namespace.
+type ConfigMapReference struct {
+	// Key contains the name of the key inside the referenced ConfigMap.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Key string `json:"key"`
+
+	// ConfigMapName contains the name of the ConfigMap containing the referenced value.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="ConfigMap Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ConfigMapName string `json:"configMapName"`
+}
+
 // BearerToken allows configuring the source of a bearer token used for authentication.
 // The token can either be read from a secret or from a Kubernetes ServiceAccount.
 // +kubebuilder:validation:XValidation:rule="self.from != 'secret' || has(self.secret)", message="Additional secret spec is required when bearer token is sourced from a secret"
@@ -56,15 +56,35 @@ const (
 	// ConditionTypeValidFilterPrefix prefixes a named filter to identify its validation state
 	ConditionTypeValidFilterPrefix = GroupName + "/ValidFilter"
 
+	// ConditionTypeAvailableOutputPrefix prefixes a named output of a failover group to identify if it is available
+	ConditionTypeAvailableOutputPrefix = GroupName + "/AvailableOutput"
+
+	// ConditionTypeDeliveringOutputPrefix prefixes a named output to identify if the collector delivers records to
+	// the output without errors
+	ConditionTypeDeliveringOutputPrefix = GroupName + "/DeliveringOutput"
+
+	// ConditionTypeFailoverPrefix prefixes a named pipeline to identify the active output of its failover group.
+	// The condition is true when an output other than the primary output of the group is active
+	ConditionTypeFailoverPrefix = GroupName + "/Failover"
+
 	// ReasonClusterRolesExist means the collector serviceAccount is bound to all the cluster roles needed to collect a log_type
 	ReasonClusterRolesExist = "ClusterRolesExist"
 
 	// ReasonClusterRoleMissing means the collector serviceAccount is missing one or more clusterRoles needed to collect a log_type
 	ReasonClusterRoleMissing = "ClusterRoleMissing"
 
+	// ReasonDegraded means the collector reported errors, discarded records or a saturated buffer for an output
+	ReasonDegraded = "Degraded"
+
+	// ReasonDelivering means the collector delivers records to an output without errors
+	ReasonDelivering = "Delivering"
+
 	// ReasonDeploymentError means an error occurred trying to deploy the collector or some related component
 	ReasonDeploymentError = "DeploymentError"
 
+	// ReasonFailoverOutputActive means an output other than the primary output of a failover group is active
+	ReasonFailoverOutputActive = "FailoverOutputActive"
+
 	// ReasonInitializationFailed indicates a failure initializing the reconciliation context
 	ReasonInitializationFailed = "InitializationFailed"
 
@@ -80,6 +100,16 @@ const (
 	// ReasonLogLevelSupported indicates the support for the log level annotation value
 	ReasonLogLevelSupported = "LogLevelSupported"
 
+	// ReasonOutputAvailable means the collector delivers records to an output or the operator can connect to its endpoint
+	ReasonOutputAvailable = "OutputAvailable"
+
+	// ReasonOutputUnavailable means the collector fails to deliver records to an output or the operator can not connect
+	// to its endpoint
+	ReasonOutputUnavailable = "OutputUnavailable"
+
+	// ReasonPrimaryOutputActive means the primary output of a failover group is active
+	ReasonPrimaryOutputActive = "PrimaryOutputActive"
+
 	// ReasonReconciliationComplete when the operator has initialized, validated, and deployed the resources for the workload
 	ReasonReconciliationComplete = "ReconciliationComplete"
 
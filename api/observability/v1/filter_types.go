@@ -14,19 +14,29 @@ limitations under the License.
 
 package v1
 
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
 // FilterType specifies the type of filter used in a pipeline
 //
-// +kubebuilder:validation:Enum:=openshiftLabels;detectMultilineException;drop;kubeApiAudit;parse;prune
+// +kubebuilder:validation:Enum:=openshiftLabels;detectMultilineException;drop;enrich;kubeApiAudit;metrics;mutate;parse;prune;redact;sample;throttle
 type FilterType string
 
 // Filter type constants, must match JSON tags of FilterTypeSpec fields.
 const (
 	FilterTypeDetectMultiline FilterType = "detectMultilineException"
 	FilterTypeDrop            FilterType = "drop"
+	FilterTypeEnrich          FilterType = "enrich"
 	FilterTypeKubeApiAudit    FilterType = "kubeApiAudit"
+	FilterTypeMetrics         FilterType = "metrics"
+	FilterTypeMutate          FilterType = "mutate"
 	FilterTypeOpenshiftLabels FilterType = "openshiftLabels"
 	FilterTypeParse           FilterType = "parse"
 	FilterTypePrune           FilterType = "prune"
+	FilterTypeRedact          FilterType = "redact"
+	FilterTypeSample          FilterType = "sample"
+	FilterTypeThrottle        FilterType = "throttle"
 )
 
 var (
@@ -34,9 +44,15 @@ var (
 		FilterTypeOpenshiftLabels,
 		FilterTypeDetectMultiline,
 		FilterTypeDrop,
+		FilterTypeEnrich,
 		FilterTypeKubeApiAudit,
+		FilterTypeMetrics,
+		FilterTypeMutate,
 		FilterTypeParse,
 		FilterTypePrune,
+		FilterTypeRedact,
+		FilterTypeSample,
+		FilterTypeThrottle,
 	}
 )
 
@@ -55,6 +71,12 @@ type FieldPath string
 // +kubebuilder:validation:XValidation:rule="self.type != 'drop' || has(self.drop)", message="Additional type specific spec is required for the filter type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'prune' || has(self.prune)", message="Additional type specific spec is required for the filter type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'openshiftLabels' || has(self.openshiftLabels)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'mutate' || has(self.mutate)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'redact' || has(self.redact)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'enrich' || has(self.enrich)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'metrics' || has(self.metrics)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'sample' || has(self.sample)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'throttle' || has(self.throttle)", message="Additional type specific spec is required for the filter type"
 type FilterSpec struct {
 	// Name used to refer to the filter from a "pipeline".
 	//
@@ -86,6 +108,61 @@ type FilterSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Prune Filters"
 	PruneFilterSpec *PruneFilterSpec `json:"prune,omitempty"`
 
+	// The ParseFilterSpec defines the parser used to parse a field of the log record and the field where the result is stored.
+	//
+	// When omitted, container log messages are parsed as JSON into the `structured` field.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Parse Filter"
+	ParseFilterSpec *ParseFilterSpec `json:"parse,omitempty"`
+
+	// A mutate filter applies an ordered list of operations that reshape a log record.
+	// Operations are applied in the order they are listed.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Mutate Filter"
+	MutateFilterSpec []MutateOperation `json:"mutate,omitempty"`
+
+	// A redact filter replaces sensitive values (e.g. email addresses, credit card numbers, tokens) found in fields
+	// of a log record.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Redact Filter"
+	RedactFilterSpec *RedactFilterSpec `json:"redact,omitempty"`
+
+	// A detectMultilineException filter reassembles the lines of exception stack traces and custom multi-line formats into single log records.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Detect Multiline Exception Filter"
+	DetectMultilineExceptionSpec *DetectMultilineExceptionFilterSpec `json:"detectMultilineException,omitempty"`
+
+	// An enrich filter attaches the columns of a lookup table row matching a key of the log record (e.g. the owning
+	// team and cost center of a namespace).
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enrich Filter"
+	EnrichFilterSpec *EnrichFilterSpec `json:"enrich,omitempty"`
+
+	// A metrics filter counts or observes the log records matching its tests as a metric exposed on the metrics
+	// endpoint of the collector.  Log records are not modified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Metrics Filter"
+	MetricsFilterSpec *MetricsFilterSpec `json:"metrics,omitempty"`
+
+	// A sample filter forwards a representative sample of the log records passing through it.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Sample Filter"
+	SampleFilterSpec *SampleFilterSpec `json:"sample,omitempty"`
+
+	// A throttle filter limits the rate of log records passing through it per key (e.g. namespace, label value, tenant).
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Throttle Filter"
+	ThrottleFilterSpec *ThrottleFilterSpec `json:"throttle,omitempty"`
+
 	// Labels applied to log records passing through a pipeline.
 	// These labels appear in the `openshift.labels` map in the log record.
 	//
@@ -104,6 +181,8 @@ type DropTest struct {
 }
 
 // +kubebuilder:validation:XValidation:rule="!(has(self.matches) && has(self.notMatches))", message="only one of matches or notMatches can be defined per field"
+// +kubebuilder:validation:XValidation:rule="[has(self.matches), has(self.notMatches), has(self.exists), has(self.equals), has(self.in), has(self.greaterThan), has(self.lessThan)].filter(x, x).size() <= 1", message="only one of matches, notMatches, exists, equals, in, greaterThan or lessThan can be defined per field"
+// +kubebuilder:validation:XValidation:rule="!has(self.caseInsensitive) || !self.caseInsensitive || has(self.matches) || has(self.notMatches) || has(self.equals) || has(self.in)", message="caseInsensitive is only supported with matches, notMatches, equals or in"
 type DropCondition struct {
 	// A dot delimited path to a field in the log record. It must start with a `.`.
 	// The path can contain alpha-numeric characters and underscores (a-zA-Z0-9_).
@@ -129,6 +208,60 @@ type DropCondition struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Keep Match Expression"
 	NotMatches string `json:"notMatches,omitempty"`
+
+	// Exists tests the presence of the field.
+	// If true, the log record will be dropped when the field exists. If false, the log record will be dropped when the field does not exist.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field Exists",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	Exists *bool `json:"exists,omitempty"`
+
+	// A value the field equals.
+	// If the value of the field, converted to a string, equals the value, the log record will be dropped.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Drop Equal Value"
+	Equals *string `json:"equals,omitempty"`
+
+	// A list of values that contains the field.
+	// If the value of the field, converted to a string, is one of the values, the log record will be dropped.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Drop Value List"
+	In []string `json:"in,omitempty"`
+
+	// A number the field is greater than, which may be a decimal number (e.g. `0.5`).
+	// If the value of the field is numeric and greater than the number, the log record will be dropped.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Drop Greater Than",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	GreaterThan *resource.Quantity `json:"greaterThan,omitempty"`
+
+	// A number the field is less than, which may be a decimal number (e.g. `0.5`).
+	// If the value of the field is numeric and less than the number, the log record will be dropped.
+	// (e.g. `.status` less than `400`)
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Drop Less Than",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	LessThan *resource.Quantity `json:"lessThan,omitempty"`
+
+	// A log level the level in the field is below.
+	// If the value of the field is a level less severe than the level, the log record will be dropped.
+	// Levels are ordered from the most to the least severe: emergency, alert, critical, error, warn, notice, info, debug, trace.
+	// The value of the field is compared without regard to case and records with any other level are not dropped.
+	// (e.g. `.level` below `warn`)
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:=emergency;alert;critical;error;warn;notice;info;debug;trace
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Drop Level Below"
+	LevelBelow string `json:"levelBelow,omitempty"`
+
+	// CaseInsensitive compares the value of the field without regard to case when testing matches, notMatches, equals or in
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Case Insensitive",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	CaseInsensitive bool `json:"caseInsensitive,omitempty"`
 }
 
 type PruneFilterSpec struct {
@@ -184,3 +317,592 @@ type PruneFilterSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fields to be kept"
 	NotIn []FieldPath `json:"notIn,omitempty"`
 }
+
+// ParserType specifies the type of parser used by a parse filter.
+//
+// +kubebuilder:validation:Enum:=json;logfmt;keyValue;regex;csv;grok;apacheCommon;apacheCombined;nginxCombined
+type ParserType string
+
+const (
+	// ParserTypeJSON parses the field as a JSON document
+	ParserTypeJSON ParserType = "json"
+
+	// ParserTypeLogfmt parses the field as a logfmt formatted string (e.g. level=info msg="hello world")
+	ParserTypeLogfmt ParserType = "logfmt"
+
+	// ParserTypeKeyValue parses the field as a list of key/value pairs using configurable delimiters
+	ParserTypeKeyValue ParserType = "keyValue"
+
+	// ParserTypeRegex parses the field using a regular expression with named capture groups
+	ParserTypeRegex ParserType = "regex"
+
+	// ParserTypeCSV parses the field as a single row of comma separated values mapped to a list of headers
+	ParserTypeCSV ParserType = "csv"
+
+	// ParserTypeGrok parses the field using a grok pattern
+	ParserTypeGrok ParserType = "grok"
+
+	// ParserTypeApacheCommon parses the field as an Apache HTTP server log in common log format
+	ParserTypeApacheCommon ParserType = "apacheCommon"
+
+	// ParserTypeApacheCombined parses the field as an Apache HTTP server log in combined log format
+	ParserTypeApacheCombined ParserType = "apacheCombined"
+
+	// ParserTypeNginxCombined parses the field as an Nginx access log in combined log format
+	ParserTypeNginxCombined ParserType = "nginxCombined"
+)
+
+// ParseFilterSpec defines how a field of a log record is parsed.
+//
+// +kubebuilder:validation:XValidation:rule="self.type != 'regex' || has(self.regex)", message="Additional parser specific spec is required for the parser type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'csv' || has(self.csv)", message="Additional parser specific spec is required for the parser type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'grok' || has(self.grok)", message="Additional parser specific spec is required for the parser type"
+type ParseFilterSpec struct {
+	// Type of parser used to parse the source field.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Parser Type"
+	Type ParserType `json:"type"`
+
+	// Source is the dot-delimited path to the field to be parsed. The value when not specified is `.message`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source Field",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Source FieldPath `json:"source,omitempty"`
+
+	// Target is the dot-delimited path to the field where the parsed result is stored. The value when not specified is `.structured`
+	//
+	// The target CANNOT be `.log_type` or `.message` as those fields are required.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target Field",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Target FieldPath `json:"target,omitempty"`
+
+	// KeepOriginal retains the source field after it is successfully parsed. The source field is removed by default.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Keep Original Field",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	KeepOriginal bool `json:"keepOriginal,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Regex Parser"
+	Regex *RegexParserSpec `json:"regex,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key/Value Parser"
+	KeyValue *KeyValueParserSpec `json:"keyValue,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="CSV Parser"
+	CSV *CSVParserSpec `json:"csv,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Grok Parser"
+	Grok *GrokParserSpec `json:"grok,omitempty"`
+}
+
+// RegexParserSpec provides the options for the `regex` parser
+type RegexParserSpec struct {
+	// Pattern is a regular expression with one or more named capture groups (e.g. `^(?P<level>\w+): (?P<msg>.*)$`).
+	// Each named capture group becomes a field of the parsed result.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pattern",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Pattern string `json:"pattern"`
+}
+
+// KeyValueParserSpec provides the options for the `keyValue` parser
+type KeyValueParserSpec struct {
+	// KeyValueDelimiter separates a key from its value. The value when not specified is `=`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key/Value Delimiter",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	KeyValueDelimiter string `json:"keyValueDelimiter,omitempty"`
+
+	// FieldDelimiter separates key/value pairs. The value when not specified is a single space
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field Delimiter",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	FieldDelimiter string `json:"fieldDelimiter,omitempty"`
+}
+
+// CSVParserSpec provides the options for the `csv` parser
+type CSVParserSpec struct {
+	// Headers is the ordered list of field names assigned to the parsed values.
+	// Values without a corresponding header are discarded.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Headers"
+	Headers []string `json:"headers"`
+
+	// Delimiter is the single character separating values. The value when not specified is `,`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Delimiter",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Delimiter string `json:"delimiter,omitempty"`
+}
+
+// GrokParserSpec provides the options for the `grok` parser
+type GrokParserSpec struct {
+	// Pattern is a grok pattern (e.g. `%{TIMESTAMP_ISO8601:timestamp} %{LOGLEVEL:level} %{GREEDYDATA:msg}`).
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pattern",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Pattern string `json:"pattern"`
+}
+
+// MutateOperationType specifies the operation applied by a mutate filter.
+//
+// +kubebuilder:validation:Enum:=rename;copy;set;delete;lowercase;uppercase;coerce
+type MutateOperationType string
+
+const (
+	// MutateOperationRename moves the value of the field to the target field
+	MutateOperationRename MutateOperationType = "rename"
+
+	// MutateOperationCopy copies the value of the field to the target field
+	MutateOperationCopy MutateOperationType = "copy"
+
+	// MutateOperationSet sets the field to a static or templated value
+	MutateOperationSet MutateOperationType = "set"
+
+	// MutateOperationDelete removes the field
+	MutateOperationDelete MutateOperationType = "delete"
+
+	// MutateOperationLowercase converts the string value of the field to lowercase
+	MutateOperationLowercase MutateOperationType = "lowercase"
+
+	// MutateOperationUppercase converts the string value of the field to uppercase
+	MutateOperationUppercase MutateOperationType = "uppercase"
+
+	// MutateOperationCoerce converts the value of the field to another type
+	MutateOperationCoerce MutateOperationType = "coerce"
+)
+
+// MutateCoerceType specifies the type a field is converted to by a coerce operation.
+//
+// +kubebuilder:validation:Enum:=int;bool;timestamp
+type MutateCoerceType string
+
+const (
+	MutateCoerceTypeInt       MutateCoerceType = "int"
+	MutateCoerceTypeBool      MutateCoerceType = "bool"
+	MutateCoerceTypeTimestamp MutateCoerceType = "timestamp"
+)
+
+// MutateOperation defines a single operation of a mutate filter.
+//
+// +kubebuilder:validation:XValidation:rule="!(self.type in ['rename', 'copy']) || has(self.target)", message="target is required for rename and copy operations"
+// +kubebuilder:validation:XValidation:rule="self.type != 'set' || has(self.value)", message="value is required for set operations"
+// +kubebuilder:validation:XValidation:rule="self.type != 'coerce' || has(self.coerceType)", message="coerceType is required for coerce operations"
+type MutateOperation struct {
+	// Type of the operation.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Operation Type"
+	Type MutateOperationType `json:"type"`
+
+	// Field is the dot-delimited path to the field the operation is applied to. It is the source of `rename` and
+	// `copy` operations.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Field FieldPath `json:"field"`
+
+	// Target is the dot-delimited path to the field that receives the value of `rename` and `copy` operations.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target Field",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Target FieldPath `json:"target,omitempty"`
+
+	// Value is the value of `set` operations. This supports template syntax to allow dynamic per-event values.
+	//
+	// A dynamic value is encased in single curly brackets `{}` and MUST end with a static fallback value separated with `||`
+	// (e.g. `{.kubernetes.labels.app||"none"}`)
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/ :@])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Value",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Value string `json:"value,omitempty"`
+
+	// CoerceType is the type the value of the field is converted to by `coerce` operations.
+	// A value that can not be converted is left unchanged.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Coerce Type"
+	CoerceType MutateCoerceType `json:"coerceType,omitempty"`
+}
+
+// RedactDetector is a built-in detector of sensitive values used by a redact filter.
+//
+// +kubebuilder:validation:Enum:=email;ipv4;ipv6;creditCard;jwt;bearerToken;awsAccessKey
+type RedactDetector string
+
+const (
+	// RedactDetectorEmail detects email addresses
+	RedactDetectorEmail RedactDetector = "email"
+
+	// RedactDetectorIPv4 detects IPv4 addresses
+	RedactDetectorIPv4 RedactDetector = "ipv4"
+
+	// RedactDetectorIPv6 detects IPv6 addresses
+	RedactDetectorIPv6 RedactDetector = "ipv6"
+
+	// RedactDetectorCreditCard detects credit card numbers that pass the Luhn checksum
+	RedactDetectorCreditCard RedactDetector = "creditCard"
+
+	// RedactDetectorJWT detects JSON web tokens
+	RedactDetectorJWT RedactDetector = "jwt"
+
+	// RedactDetectorBearerToken detects bearer tokens of authorization headers (e.g. `Bearer abc123`)
+	RedactDetectorBearerToken RedactDetector = "bearerToken"
+
+	// RedactDetectorAWSAccessKey detects AWS access key IDs
+	RedactDetectorAWSAccessKey RedactDetector = "awsAccessKey"
+)
+
+// RedactFilterSpec defines the sensitive values replaced by a redact filter and how they are replaced.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.detectors) || has(self.patterns)", message="at least one of detectors or patterns is required"
+type RedactFilterSpec struct {
+	// Detectors are the built-in detectors of sensitive values
+	//
+	// +kubebuilder:validation:Optional
+	// +listType:=set
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Detectors"
+	Detectors []RedactDetector `json:"detectors,omitempty"`
+
+	// Patterns are regular expressions that match additional sensitive values
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Patterns"
+	Patterns []string `json:"patterns,omitempty"`
+
+	// Fields are the dot-delimited paths to the string fields that are redacted. The value when not specified is `.message`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fields"
+	Fields []FieldPath `json:"fields,omitempty"`
+
+	// Replacement defines how a sensitive value is replaced. Values are masked when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replacement"
+	Replacement *RedactReplacement `json:"replacement,omitempty"`
+}
+
+// RedactReplacementType specifies how a sensitive value is replaced.
+//
+// +kubebuilder:validation:Enum:=mask;text;hash
+type RedactReplacementType string
+
+const (
+	// RedactReplacementMask replaces each character of the value with `*`
+	RedactReplacementMask RedactReplacementType = "mask"
+
+	// RedactReplacementText replaces the value with a fixed string
+	RedactReplacementText RedactReplacementType = "text"
+
+	// RedactReplacementHash replaces the value with its salted SHA-256 hash so equal values can still be correlated
+	RedactReplacementHash RedactReplacementType = "hash"
+)
+
+// RedactReplacement defines how a sensitive value is replaced.
+//
+// +kubebuilder:validation:XValidation:rule="self.type != 'text' || has(self.text)", message="text is required for the text replacement"
+type RedactReplacement struct {
+	// Type of replacement.
+	//
+	// +kubebuilder:default:=mask
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replacement Type"
+	Type RedactReplacementType `json:"type,omitempty"`
+
+	// Text is the fixed string that replaces a sensitive value (e.g. `[REDACTED]`)
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Text",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Text string `json:"text,omitempty"`
+
+	// Salt is the secret key whose value is prepended to a sensitive value before it is hashed.
+	// The value can not contain a double quote, backslash, brace or line break
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Salt"
+	Salt *SecretReference `json:"salt,omitempty"`
+}
+
+// SampleFilterSpec defines the rate at which log records are sampled and the records that are never sampled away.
+type SampleFilterSpec struct {
+	// Rate is the sampling rate. One log record out of every `rate` records is forwarded (e.g. a rate of 10 forwards 10% of the records).
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rate",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	Rate int64 `json:"rate"`
+
+	// KeyField is the dot-delimited path to a field whose value determines if a log record is sampled.  All records
+	// with the same value are either forwarded or dropped together (e.g. `.kubernetes.pod_name` or a trace ID).
+	// Records are sampled independently when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Field",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	KeyField FieldPath `json:"keyField,omitempty"`
+
+	// Exclude is an array of tests for log records that are always forwarded and never sampled away (e.g. errors).
+	// A record is excluded if any test passes.  Tests have the same conditions as a drop filter.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Exclude"
+	Exclude []DropTest `json:"exclude,omitempty"`
+}
+
+// ThrottleAction is the action taken on log records exceeding the threshold of a throttle filter.
+//
+// +kubebuilder:validation:Enum:=drop;tag
+type ThrottleAction string
+
+const (
+	// ThrottleActionDrop drops the log records exceeding the threshold
+	ThrottleActionDrop ThrottleAction = "drop"
+
+	// ThrottleActionTag forwards the log records exceeding the threshold with the `throttled` field set to true
+	ThrottleActionTag ThrottleAction = "tag"
+)
+
+// ThrottleFilterSpec defines the maximum rate of log records per key.
+type ThrottleFilterSpec struct {
+	// Key is a template evaluated for each log record to determine the bucket it is counted against.
+	// All records are counted against a single bucket when not specified.
+	//
+	// The template can be a combination of static and dynamic values, in the same format as the index of an output.
+	// Dynamic values are enclosed in single curly brackets `{}` and must end with a static fallback value separated with `||`.
+	//
+	// Examples:
+	//
+	//  1. {.kubernetes.namespace_name||"none"}
+	//
+	//  2. {.kubernetes.labels.tenant||.kubernetes.namespace_name||"none"}
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Key string `json:"key,omitempty"`
+
+	// Threshold is the maximum number of log records per key allowed in a window.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Threshold",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	Threshold int64 `json:"threshold"`
+
+	// WindowSeconds is the length in seconds of the window the threshold applies to.
+	//
+	// +kubebuilder:default:=1
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Window Seconds",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	WindowSeconds int64 `json:"windowSeconds,omitempty"`
+
+	// Action is taken on log records exceeding the threshold.
+	//
+	// +kubebuilder:default:=drop
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Action"
+	Action ThrottleAction `json:"action,omitempty"`
+}
+
+// MultilineLanguage is a programming language whose exception stack traces are detected.
+//
+// +kubebuilder:validation:Enum:=java;python;go;ruby;js;php;dart;dotnet
+type MultilineLanguage string
+
+const (
+	MultilineLanguageJava   MultilineLanguage = "java"
+	MultilineLanguagePython MultilineLanguage = "python"
+	MultilineLanguageGo     MultilineLanguage = "go"
+	MultilineLanguageRuby   MultilineLanguage = "ruby"
+	MultilineLanguageJS     MultilineLanguage = "js"
+	MultilineLanguagePHP    MultilineLanguage = "php"
+	MultilineLanguageDart   MultilineLanguage = "dart"
+	MultilineLanguageDotNet MultilineLanguage = "dotnet"
+)
+
+// DetectMultilineExceptionFilterSpec defines the languages, timeouts and custom rules of multi-line detection.
+type DetectMultilineExceptionFilterSpec struct {
+	// Languages whose exception stack traces are detected. All languages are detected when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType:=set
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Languages"
+	Languages []MultilineLanguage `json:"languages,omitempty"`
+
+	// ExpireAfterMilliseconds is the maximum time a partial multi-line record waits for its next line before it is forwarded.
+	//
+	// +kubebuilder:default:=2000
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Expire After Milliseconds",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	ExpireAfterMilliseconds int64 `json:"expireAfterMilliseconds,omitempty"`
+
+	// FlushIntervalMilliseconds is the interval at which expired multi-line records are flushed.
+	//
+	// +kubebuilder:default:=1000
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Flush Interval Milliseconds",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	FlushIntervalMilliseconds int64 `json:"flushIntervalMilliseconds,omitempty"`
+
+	// Rules are custom multi-line formats that are not stack traces (e.g. multi-line SQL dumps)
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rules"
+	Rules []MultilineRule `json:"rules,omitempty"`
+}
+
+// MultilineRule defines the lines of a custom multi-line format.
+type MultilineRule struct {
+	// StartPattern is a regular expression matching the first line of a multi-line record
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Start Pattern",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	StartPattern string `json:"startPattern"`
+
+	// ContinuationPattern is a regular expression matching the following lines of a multi-line record.
+	// Every line up to the next line matching the start pattern is a continuation when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Continuation Pattern",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ContinuationPattern string `json:"continuationPattern,omitempty"`
+}
+
+// EnrichTableFormat is the format of a lookup table.
+//
+// +kubebuilder:validation:Enum:=csv;json
+type EnrichTableFormat string
+
+const (
+	// EnrichTableFormatCSV is a table of comma separated values where the first line is the header of column names
+	EnrichTableFormatCSV EnrichTableFormat = "csv"
+
+	// EnrichTableFormatJSON is a table of rows formatted as a JSON array of objects of column names to string values
+	EnrichTableFormatJSON EnrichTableFormat = "json"
+)
+
+// EnrichFilterSpec defines the lookup table, the key and the columns attached to log records.
+type EnrichFilterSpec struct {
+	// Table is the ConfigMap key containing the lookup table
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Table"
+	Table ConfigMapReference `json:"table"`
+
+	// Format of the lookup table.
+	//
+	// +kubebuilder:default:=csv
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Format"
+	Format EnrichTableFormat `json:"format,omitempty"`
+
+	// Key is a template evaluated for each log record that is matched against the key column of the table.
+	//
+	// The template can be a combination of static and dynamic values, in the same format as the index of an output.
+	// Dynamic values are enclosed in single curly brackets `{}` and must end with a static fallback value separated with `||`.
+	//
+	// Example: {.kubernetes.namespace_name||"none"}
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Key string `json:"key"`
+
+	// KeyColumn is the name of the table column whose values are matched against the key.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Column",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	KeyColumn string `json:"keyColumn"`
+
+	// Columns are the names of the table columns attached to log records matching a row.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +listType:=set
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Columns"
+	Columns []string `json:"columns"`
+
+	// Target is the dot-delimited path of the field the columns are attached to. Defaults to `.enrichment`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Target FieldPath `json:"target,omitempty"`
+}
+
+// LogMetricType is the type of metric generated from log records.
+//
+// +kubebuilder:validation:Enum:=counter;histogram
+type LogMetricType string
+
+const (
+	// LogMetricTypeCounter counts the matching log records
+	LogMetricTypeCounter LogMetricType = "counter"
+
+	// LogMetricTypeHistogram observes the numeric value of a field of the matching log records
+	LogMetricTypeHistogram LogMetricType = "histogram"
+)
+
+// MetricsFilterSpec defines a metric generated from log records.
+//
+// +kubebuilder:validation:XValidation:rule="self.type != 'histogram' || has(self.field)", message="field is required for histograms"
+type MetricsFilterSpec struct {
+	// Name of the metric.  The metric is exposed with the `collector_` prefix (e.g. a name of `error_logs_total` is
+	// exposed as `collector_error_logs_total`)
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Metric Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Name string `json:"name"`
+
+	// Type of the metric.
+	//
+	// +kubebuilder:default:=counter
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Metric Type"
+	Type LogMetricType `json:"type,omitempty"`
+
+	// Field is the dot-delimited path of the numeric field observed by a histogram (e.g. `.structured.duration_ms`)
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Field FieldPath `json:"field,omitempty"`
+
+	// Labels of the metric, keyed by label name.  Values are templates evaluated for each log record, in the same
+	// format as the index of an output (e.g. `{.kubernetes.namespace_name||"none"}`)
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxProperties:=10
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Labels"
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// MaxLabelValues is the maximum number of distinct values of each label.  Log records with new values exceeding
+	// the limit are not counted.
+	//
+	// +kubebuilder:default:=100
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=1000
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Label Values",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	MaxLabelValues int64 `json:"maxLabelValues,omitempty"`
+
+	// Match is an array of tests of the log records that generate the metric.  A record matches if any test passes.
+	// Tests have the same conditions as a drop filter.  All records match when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Match"
+	Match []DropTest `json:"match,omitempty"`
+}
@@ -15,12 +15,13 @@ limitations under the License.
 package v1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // InputType specifies the type of log input to create.
 //
-// +kubebuilder:validation:Enum:=audit;application;infrastructure;receiver
+// +kubebuilder:validation:Enum:=audit;application;infrastructure;receiver;events
 type InputType string
 
 const (
@@ -32,6 +33,8 @@ const (
 	InputTypeAudit InputType = "audit"
 	// InputTypeReceiver defines a network receiver for receiving logs from non-cluster sources.
 	InputTypeReceiver InputType = "receiver"
+	// InputTypeEvents contains the Kubernetes events of the cluster collected by an event watcher.
+	InputTypeEvents InputType = "events"
 )
 
 var (
@@ -40,6 +43,7 @@ var (
 		InputTypeInfrastructure,
 		InputTypeAudit,
 		InputTypeReceiver,
+		InputTypeEvents,
 	}
 )
 
@@ -86,6 +90,12 @@ type InputSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Receiver"
 	Receiver *ReceiverSpec `json:"receiver,omitempty"`
+
+	// Events, enables Kubernetes `events` collected by an event watcher deployed by the operator.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kubernetes Events Input"
+	Events *Events `json:"events,omitempty"`
 }
 
 type ContainerInputTuningSpec struct {
@@ -123,6 +133,17 @@ type Application struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pod Selector",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:selector:core:v1:Pod"}
 	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 
+	// NamespaceSelector for logs from namespaces with matching labels.
+	//
+	// Only messages from pods in namespaces with these labels are collected. The service account of the forwarder
+	// must be able to read namespaces.
+	//
+	// If absent or empty, logs are collected regardless of namespace labels.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace Selector",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:selector:core:v1:Namespace"}
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
 	// Tuning is the container input tuning spec for this container sources
 	//
 	// +kubebuilder:validation:Optional
@@ -186,7 +207,7 @@ var (
 
 // Infrastructure enables infrastructure logs.
 // Sources of these logs:
-// * container workloads deployed to namespaces: default, kube*, openshift*
+// * container workloads deployed to namespaces: default, kube*, openshift* and the infrastructureNamespaces of the forwarder
 // * journald logs from cluster nodes
 type Infrastructure struct {
 	// Sources defines the list of infrastructure sources to collect.
@@ -235,26 +256,54 @@ type Audit struct {
 	Sources []AuditSource `json:"sources,omitempty"`
 }
 
+// Events enables Kubernetes events.
+//
+// The operator deploys an event watcher in the namespace of the forwarder which writes the events in the format of the
+// eventrouter. Events are forwarded as `infrastructure` logs.
+type Events struct {
+	// Namespaces of the events to collect. Supports glob patterns (e.g. openshift-*).
+	//
+	// If absent or empty, events of all namespaces are collected.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self.all(n, n.matches('^[a-z0-9*]([-a-z0-9*]*[a-z0-9*])?$'))", message="Namespaces must be namespace names or globs"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace Globs"
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// ExcludeNamespaces of the events not to collect. Supports glob patterns (e.g. openshift-*).
+	//
+	// Exclusions take precedence over namespaces.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self.all(n, n.matches('^[a-z0-9*]([-a-z0-9*]*[a-z0-9*])?$'))", message="Namespaces must be namespace names or globs"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Exclude Namespace Globs"
+	ExcludeNamespaces []string `json:"excludeNamespaces,omitempty"`
+}
+
 // ReceiverType specifies the type of receiver that should be created.
 //
-// +kubebuilder:validation:Enum:=http;syslog
+// +kubebuilder:validation:Enum:=http;syslog;otlp
 type ReceiverType string
 
 const (
 	ReceiverTypeHTTP   ReceiverType = "http"
 	ReceiverTypeSyslog ReceiverType = "syslog"
+	ReceiverTypeOTLP   ReceiverType = "otlp"
 )
 
 var (
 	ReceiverTypes = []ReceiverType{
 		ReceiverTypeHTTP,
 		ReceiverTypeSyslog,
+		ReceiverTypeOTLP,
 	}
 )
 
 type InputTLSSpec TLSSpec
 
 // ReceiverSpec is a union of input Receiver types.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.syslog) || !has(self.syslog.clientCA) || !has(self.tls) || !has(self.tls.ca)", message="tls.ca and syslog.clientCA are mutually exclusive"
 type ReceiverSpec struct {
 	// Type of Receiver plugin.
 	//
@@ -282,22 +331,137 @@ type ReceiverSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="HTTP Receiver Configuration"
 	HTTP *HTTPReceiver `json:"http,omitempty"`
+
+	// Syslog configures the receiver of syslog messages
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Syslog Receiver Configuration"
+	Syslog *SyslogReceiver `json:"syslog,omitempty"`
+
+	// OTLP configures the receiver of OpenTelemetry logs.  Port is the OTLP/HTTP port of the receiver
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OTLP Receiver Configuration"
+	OTLP *OTLPReceiver `json:"otlp,omitempty"`
+}
+
+// SyslogReceiverProtocol is the transport protocol of a syslog receiver
+//
+// +kubebuilder:validation:Enum:=tcp;udp;both
+type SyslogReceiverProtocol string
+
+const (
+	SyslogReceiverProtocolTCP  SyslogReceiverProtocol = "tcp"
+	SyslogReceiverProtocolUDP  SyslogReceiverProtocol = "udp"
+	SyslogReceiverProtocolBoth SyslogReceiverProtocol = "both"
+)
+
+// SyslogReceiver receives syslog messages over TCP, UDP or both on the port of the receiver
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.clientCA) || !has(self.protocol) || self.protocol != 'udp'", message="clientCA requires the tcp protocol"
+type SyslogReceiver struct {
+	// Protocol is the transport protocol of the receiver.  TLS is only supported for TCP
+	//
+	// +kubebuilder:default:=tcp
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Protocol",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:tcp","urn:alm:descriptor:com.tectonic.ui:select:udp","urn:alm:descriptor:com.tectonic.ui:select:both"}
+	Protocol SyslogReceiverProtocol `json:"protocol,omitempty"`
+
+	// ClientCA is the certificate authority that signs the certificates clients must present to connect to the receiver.
+	// Client certificates are not required when it is not spec'd
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Client CA"
+	ClientCA *ValueReference `json:"clientCA,omitempty"`
+
+	// MaxMessageSize is the maximum size of a syslog message.  Larger messages are discarded
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Message Size"
+	MaxMessageSize *resource.Quantity `json:"maxMessageSize,omitempty"`
+
+	// RFC is the format of the syslog messages.  Messages of any other format are discarded.
+	// Messages formatted using either RFC are accepted when it is not spec'd
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="RFC",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:rfc3164","urn:alm:descriptor:com.tectonic.ui:select:rfc5424"}
+	RFC SyslogRFCType `json:"rfc,omitempty"`
+}
+
+// OTLPReceiver receives logs using the OpenTelemetry protocol over gRPC and HTTP.
+//
+// Log records are mapped into the ViaQ data model as application logs. The kubernetes metadata of a record
+// is taken from its 'k8s.namespace.name', 'k8s.pod.name' and 'k8s.container.name' resource attributes
+type OTLPReceiver struct {
+	// GRPCPort the Receiver listens on for OTLP/gRPC requests. It must be a value between 1024 and 65535
+	//
+	// +kubebuilder:default:=4317
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1024
+	// +kubebuilder:validation:Maximum:=65535
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="gRPC Listen Port",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	GRPCPort int32 `json:"grpcPort,omitempty"`
 }
 
 // HTTPReceiverFormat defines the type of log data incoming through the HTTP receiver.
 //
-// +kubebuilder:validation:Enum:=kubeApiAudit
+// +kubebuilder:validation:Enum:=kubeApiAudit;json;ndjson;raw
 type HTTPReceiverFormat string
 
 const (
 	HTTPReceiverFormatKubeApiAudit HTTPReceiverFormat = "kubeApiAudit"
+
+	// HTTPReceiverFormatJSON is a JSON object or a JSON array of objects per request
+	HTTPReceiverFormatJSON HTTPReceiverFormat = "json"
+
+	// HTTPReceiverFormatNDJSON is newline delimited JSON objects
+	HTTPReceiverFormatNDJSON HTTPReceiverFormat = "ndjson"
+
+	// HTTPReceiverFormatRaw is newline delimited lines of text
+	HTTPReceiverFormatRaw HTTPReceiverFormat = "raw"
 )
 
 // HTTPReceiver receives encoded logs as a HTTP endpoint.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.fieldMappings) || self.format in ['json', 'ndjson']", message="fieldMappings are only supported for the json and ndjson formats"
 type HTTPReceiver struct {
 	// Format is the format of incoming log data.
 	//
+	// Logs received in any format other than `kubeApiAudit` are forwarded as application logs
+	//
 	// +kubebuilder:validation:Required
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Data Format"
 	Format HTTPReceiverFormat `json:"format"`
+
+	// FieldMappings identifies the fields of JSON log records that are mapped to the message, timestamp and level
+	// of a log.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field Mappings"
+	FieldMappings *HTTPReceiverFieldMappings `json:"fieldMappings,omitempty"`
+}
+
+// HTTPReceiverFieldMappings are the paths of the fields of a JSON log record that map to the fields of a log.
+//
+// The JSON log record is forwarded as the structured content of the log.  The message of the log is the
+// JSON encoded record when the message field is not spec'd or is missing from the record.
+type HTTPReceiverFieldMappings struct {
+	// Message is the path to the field containing the message of the log (e.g. `.msg`)
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Message Field",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Message FieldPath `json:"message,omitempty"`
+
+	// Timestamp is the path to the field containing the RFC3339 timestamp of the log (e.g. `.time`).
+	// The time the log is received is used when the field is not spec'd, missing or can not be parsed.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Timestamp Field",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Timestamp FieldPath `json:"timestamp,omitempty"`
+
+	// Level is the path to the field containing the severity of the log (e.g. `.severity`)
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Level Field",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Level FieldPath `json:"level,omitempty"`
 }
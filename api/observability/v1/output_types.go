@@ -23,7 +23,7 @@ import (
 
 // OutputType is used to define the type of output to be created.
 //
-// +kubebuilder:validation:Enum:=azureMonitor;cloudwatch;elasticsearch;http;kafka;loki;lokiStack;googleCloudLogging;splunk;syslog;otlp
+// +kubebuilder:validation:Enum:=azureMonitor;cloudwatch;elasticsearch;http;kafka;loki;lokiStack;googleCloudLogging;s3;splunk;syslog;otlp
 type OutputType string
 
 // Output type constants, must match JSON tags of OutputTypeSpec fields.
@@ -37,6 +37,7 @@ const (
 	OutputTypeLoki               OutputType = "loki"
 	OutputTypeLokiStack          OutputType = "lokiStack"
 	OutputTypeOTLP               OutputType = "otlp"
+	OutputTypeS3                 OutputType = "s3"
 	OutputTypeSplunk             OutputType = "splunk"
 	OutputTypeSyslog             OutputType = "syslog"
 )
@@ -52,6 +53,7 @@ var (
 		OutputTypeKafka,
 		OutputTypeLoki,
 		OutputTypeLokiStack,
+		OutputTypeS3,
 		OutputTypeSplunk,
 		OutputTypeSyslog,
 		OutputTypeOTLP,
@@ -68,9 +70,11 @@ var (
 // +kubebuilder:validation:XValidation:rule="self.type != 'kafka' || has(self.kafka)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'loki' || has(self.loki)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'lokiStack' || has(self.lokiStack)", message="Additional type specific spec is required for the output type"
+// +kubebuilder:validation:XValidation:rule="self.type != 's3' || has(self.s3)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'splunk' || has(self.splunk)", message="Additional type specific spec is required the for output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'syslog' || has(self.syslog)", message="Additional type specific spec is required the for output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'otlp' || has(self.otlp)", message="Additional type specific spec is required the for output type"
+// +kubebuilder:validation:XValidation:rule="!has(self.transformFailureOutputRef) || self.transformFailureOutputRef != self.name", message="An output can not be its own transform failure output"
 type OutputSpec struct {
 	// Name used to refer to the output from a `pipeline`.
 	//
@@ -99,6 +103,18 @@ type OutputSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rate Limiting"
 	Limit *LimitSpec `json:"rateLimit,omitempty"`
 
+	// TransformFailureOutputRef is the name of another output where events are rerouted when they fail a transform
+	// of this output before they are sent (e.g. a templated value can not be converted to a string). Rerouted events
+	// are annotated with the `transform_failure` field containing the name of this output and the reason they were rerouted.
+	//
+	// This is not a dead letter queue. Events rejected by the remote service after they are sent
+	// (e.g. Elasticsearch mapping conflicts or Splunk 400 responses) are not rerouted and are dropped.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:="^[a-z][a-z0-9-]*[a-z0-9]$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Transform Failure Output"
+	TransformFailureOutputRef string `json:"transformFailureOutputRef,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Azure Monitor"
 	AzureMonitor *AzureMonitor `json:"azureMonitor,omitempty"`
@@ -131,6 +147,10 @@ type OutputSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="LokiStack"
 	LokiStack *LokiStack `json:"lokiStack,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Amazon S3"
+	S3 *S3 `json:"s3,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Splunk"
 	Splunk *Splunk `json:"splunk,omitempty"`
@@ -195,6 +215,14 @@ type BaseOutputTuningSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maximum Retry Duration"
 	MaxRetryDuration *time.Duration `json:"maxRetryDuration,omitempty"`
+
+	// Buffer configures the buffer where records are stored before they are sent to the output.
+	// It takes precedence over the buffer implied by the DeliveryMode.
+	//
+	// +kubebuilder:validation:Optional
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Buffer"
+	Buffer *OutputBufferSpec `json:"buffer,omitempty"`
 }
 
 // DeliveryMode sets the delivery mode for log forwarding.
@@ -213,6 +241,66 @@ const (
 	DeliveryModeAtMostOnce DeliveryMode = "atMostOnce"
 )
 
+// BufferType sets where the records of an output buffer are stored.
+//
+// +kubebuilder:validation:Enum:=memory;disk
+type BufferType string
+
+const (
+	// BufferTypeMemory stores records in memory. Records are lost if the collector is restarted.
+	BufferTypeMemory BufferType = "memory"
+
+	// BufferTypeDisk stores records in the data directory on the node. Records survive restarts of the collector.
+	BufferTypeDisk BufferType = "disk"
+)
+
+// BufferWhenFull sets the behavior of an output buffer when it is full.
+//
+// +kubebuilder:validation:Enum:=block;dropNewest
+type BufferWhenFull string
+
+const (
+	// BufferWhenFullBlock applies backpressure until there is room in the buffer.
+	BufferWhenFullBlock BufferWhenFull = "block"
+
+	// BufferWhenFullDropNewest drops the records received while the buffer is full.
+	BufferWhenFullDropNewest BufferWhenFull = "dropNewest"
+)
+
+// OutputBufferSpec configures the buffer of an output
+//
+// +kubebuilder:validation:XValidation:rule="self.type != 'disk' || has(self.maxSize)", message="maxSize is required for a disk buffer"
+// +kubebuilder:validation:XValidation:rule="self.type != 'disk' || !has(self.maxEvents)", message="maxEvents is only supported by a memory buffer"
+// +kubebuilder:validation:XValidation:rule="self.type != 'memory' || !has(self.maxSize)", message="maxSize is only supported by a disk buffer"
+type OutputBufferSpec struct {
+	// Type of the buffer
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Buffer Type"
+	Type BufferType `json:"type"`
+
+	// MaxSize is the maximum size of a disk buffer. It must be at least 256Mi.
+	//
+	// The disk buffers of all outputs of a forwarder must fit in the forwarder disk buffer budget of the collector
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maximum Size"
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+
+	// MaxEvents is the maximum number of records held by a memory buffer.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maximum Events",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	MaxEvents *int64 `json:"maxEvents,omitempty"`
+
+	// WhenFull is the behavior of the buffer when it is full.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="When Full"
+	WhenFull BufferWhenFull `json:"whenFull,omitempty"`
+}
+
 // HTTPAuthentication provides options for setting common authentication credentials.
 // This is mostly used with outputs using HTTP or a derivative as transport.
 type HTTPAuthentication struct {
@@ -420,6 +508,82 @@ type CloudwatchAWSAccessKey struct {
 	KeySecret SecretReference `json:"keySecret"`
 }
 
+type S3TuningSpec struct {
+	BaseOutputTuningSpec `json:",inline"`
+
+	// Compression causes data to be compressed before sending over the network.
+	// It is an error if the compression type is not supported by the output.
+	//
+	// Default: gzip
+	//
+	// +kubebuilder:validation:Enum:=gzip;none;snappy;zlib;zstd
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Compression"
+	Compression string `json:"compression,omitempty"`
+}
+
+// S3 provides configuration for the output type `s3`.
+//
+// Records are written as newline delimited JSON objects
+type S3 struct {
+	// URL to send log records to. This is only required for S3-compatible object stores
+	// or to override the default regional endpoint.
+	//
+	// The 'username@password' part of `url` is ignored.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == '' ||  isURL(self)", message="invalid URL"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Destination URL",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	URL string `json:"url,omitempty"`
+
+	// Authentication sets credentials for authenticating the requests.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication Options"
+	Authentication *CloudwatchAuthentication `json:"authentication"`
+
+	// Tuning specs tuning for the output
+	//
+	// +kubebuilder:validation:Optional
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tuning Options"
+	Tuning *S3TuningSpec `json:"tuning,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Amazon Region",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Region string `json:"region"`
+
+	// Bucket is the name of the S3 bucket where log records are stored.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Bucket",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Bucket string `json:"bucket"`
+
+	// KeyPrefix is the prefix of the object keys written to the bucket. This supports template syntax to allow
+	// dynamic per-event values. A trailing `/` causes objects to be grouped in a "folder"
+	//
+	// The KeyPrefix can be a combination of static and dynamic values consisting of field paths followed by `||` followed by another field path or a static value.
+	//
+	// A dynamic value is encased in single curly brackets `{}` and MUST end with a static fallback value separated with `||`.
+	//
+	// Static values can only contain alphanumeric characters along with dashes, underscores, dots and forward slashes.
+	//
+	// Example:
+	//
+	//  1. foo-{.bar||"none"}/
+	//
+	//  2. {.foo||.bar||"missing"}/
+	//
+	//  3. foo.{.bar.baz||.qux.quux.corge||.grault||"nil"}-waldo.fred{.plugh||"none"}/
+	//
+	// Default: `{.log_type||"unknown"}/`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Prefix",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+}
+
 type ElasticsearchTuningSpec struct {
 	BaseOutputTuningSpec `json:",inline"`
 
@@ -476,12 +640,101 @@ type Elasticsearch struct {
 }
 
 // GoogleCloudLoggingAuthentication contains configuration for authenticating requests to a GoogleCloudLogging output.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.credentials) != has(self.workloadIdentity)", message="Exactly one of credentials or workloadIdentity is required"
 type GoogleCloudLoggingAuthentication struct {
 	// Credentials points to the secret containing the `google-application-credentials.json`.
 	//
-	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret with Credentials File"
-	Credentials *SecretReference `json:"credentials"`
+	Credentials *SecretReference `json:"credentials,omitempty"`
+
+	// WorkloadIdentity configures the token exchanged for short-lived credentials using GCP workload identity
+	// federation instead of a long-lived service account key.
+	//
+	// +kubebuilder:validation:Optional
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Workload Identity"
+	WorkloadIdentity *GoogleCloudLoggingWorkloadIdentity `json:"workloadIdentity,omitempty"`
+}
+
+type GoogleCloudLoggingWorkloadIdentity struct {
+	// Audience is the full resource name of the provider of the workload identity pool.
+	// The provider must allow the `openshift` audience of the service account token.
+	//
+	// Example: //iam.googleapis.com/projects/123456789/locations/global/workloadIdentityPools/my-pool/providers/my-provider
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^//iam\.googleapis\.com/projects/[0-9]+/locations/global/workloadIdentityPools/[^/]+/providers/[^/]+$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Provider Audience",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Audience string `json:"audience"`
+
+	// ServiceAccountEmail is the email of the GCP service account impersonated by the federated identity.
+	// The federated identity is granted access directly when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Service Account Email",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ServiceAccountEmail string `json:"serviceAccountEmail,omitempty"`
+
+	// Token specifies the token exchanged for the credentials. A token from the serviceAccount is the projected
+	// token of the forwarder service account
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Token"
+	Token BearerToken `json:"token"`
+}
+
+// GoogleCloudLoggingResourceType is the type of the monitored resource of the log entries.
+//
+// +kubebuilder:validation:Enum:=k8sNode;k8sContainer
+type GoogleCloudLoggingResourceType string
+
+const (
+	// GoogleCloudLoggingResourceTypeK8sNode associates all log entries with the node of the collector
+	GoogleCloudLoggingResourceTypeK8sNode GoogleCloudLoggingResourceType = "k8sNode"
+
+	// GoogleCloudLoggingResourceTypeK8sContainer associates all log entries with the container of their pod
+	GoogleCloudLoggingResourceTypeK8sContainer GoogleCloudLoggingResourceType = "k8sContainer"
+)
+
+// GoogleCloudLoggingResource configures the monitored resource of the log entries.
+type GoogleCloudLoggingResource struct {
+	// Type of the monitored resource. Log entries without a pod (e.g. journal logs) have the `none` namespace, pod and
+	// container of the default labels when the type is `k8sContainer`; send them to an output with the `k8sNode` type
+	// to associate them with their node instead.
+	//
+	// +kubebuilder:default:=k8sContainer
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resource Type"
+	Type GoogleCloudLoggingResourceType `json:"type,omitempty"`
+
+	// ProjectId is the GCP project of the cluster. Defaults to the value of the `id` of the output when its type is `project`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Project ID",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ProjectId string `json:"projectId,omitempty"`
+
+	// Location is the GCP zone or region of the cluster (e.g. us-central1)
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Location",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Location string `json:"location,omitempty"`
+
+	// ClusterName is the name of the cluster
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Labels maps labels of the monitored resource to templates evaluated for each log entry, replacing their default
+	// value. Labels must be one of `project_id`, `location`, `cluster_name`, `namespace_name`, `pod_name`,
+	// `container_name` or `node_name`, and are applied to the resources with the label.
+	//
+	// Templates have the same format as the `logId` (e.g. `{.kubernetes.labels.app||"none"}`)
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resource Labels"
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 type GoogleCloudLoggingTuningSpec struct {
@@ -522,6 +775,14 @@ type GoogleCloudLogging struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Stream ID",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	LogId string `json:"logId"`
 
+	// Resource configures the monitored resource of the log entries. All log entries are associated with the
+	// `k8s_container` resource of their container when not specified
+	//
+	// +kubebuilder:validation:Optional
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Monitored Resource"
+	Resource *GoogleCloudLoggingResource `json:"resource,omitempty"`
+
 	// Tuning specs tuning for the output
 	//
 	// +kubebuilder:validation:Optional
@@ -611,6 +872,14 @@ type KafkaTuningSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Batch Size"
 	MaxWrite *resource.Quantity `json:"maxWrite,omitempty"`
 
+	// Buffer configures the buffer where records are stored before they are sent to the output.
+	// It takes precedence over the buffer implied by the DeliveryMode.
+	//
+	// +kubebuilder:validation:Optional
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Buffer"
+	Buffer *OutputBufferSpec `json:"buffer,omitempty"`
+
 	// Compression causes data to be compressed before sending over the network.
 	//
 	// +kubebuilder:validation:Enum:=none;snappy;zstd;lz4
@@ -948,6 +1217,8 @@ type SplunkAuthentication struct {
 
 // Splunk Deliver log data to Splunk’s HTTP Event Collector
 // Provides optional extra properties for `type: splunk_hec` ('splunk_hec_logs' after Vector 0.23
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.indexedFields) || !has(self.endpointTarget) || self.endpointTarget == 'event'", message="indexedFields are only supported by the event endpointTarget"
 type Splunk struct {
 	// Authentication sets credentials for authenticating the requests.
 	//
@@ -984,8 +1255,63 @@ type Splunk struct {
 	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Index",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	Index string `json:"index,omitempty"`
+
+	// Source is the source of the logs. This supports template syntax to allow dynamic per-event values.
+	//
+	// A dynamic value is encased in single curly brackets `{}` and MUST end with a static fallback value separated with `||`
+	// (e.g. `{.kubernetes.namespace_name||"none"}`)
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Source string `json:"source,omitempty"`
+
+	// SourceType is the sourcetype of the logs. This supports template syntax to allow dynamic per-event values.
+	//
+	// A dynamic value is encased in single curly brackets `{}` and MUST end with a static fallback value separated with `||`
+	// (e.g. `{.kubernetes.namespace_name||"none"}`)
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source Type",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	SourceType string `json:"sourceType,omitempty"`
+
+	// Host is the host of the logs. This supports template syntax to allow dynamic per-event values.
+	//
+	// A dynamic value is encased in single curly brackets `{}` and MUST end with a static fallback value separated with `||`
+	// (e.g. `{.kubernetes.namespace_name||"none"}`)
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Host",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Host string `json:"host,omitempty"`
+
+	// EndpointTarget is the HTTP Event Collector endpoint that receives the logs.  Logs sent to the `raw` endpoint
+	// are not wrapped in a HEC event and do not support indexed fields
+	//
+	// +kubebuilder:default:=event
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Endpoint Target",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:event","urn:alm:descriptor:com.tectonic.ui:select:raw"}
+	EndpointTarget SplunkEndpointTarget `json:"endpointTarget,omitempty"`
+
+	// IndexedFields are the paths of the fields of a log that are sent as indexed fields of the HEC event
+	// (e.g. `.kubernetes.namespace_name`)
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Indexed Fields"
+	IndexedFields []FieldPath `json:"indexedFields,omitempty"`
 }
 
+// SplunkEndpointTarget is the HTTP Event Collector endpoint of a Splunk output
+//
+// +kubebuilder:validation:Enum:=event;raw
+type SplunkEndpointTarget string
+
+const (
+	SplunkEndpointTargetEvent SplunkEndpointTarget = "event"
+	SplunkEndpointTargetRaw   SplunkEndpointTarget = "raw"
+)
+
 // SyslogRFCType sets which RFC the generated messages conform to.
 //
 // +kubebuilder:validation:Enum:=rfc3164;rfc5424
@@ -996,6 +1322,15 @@ const (
 	SyslogRFC5424 SyslogRFCType = "rfc5424"
 )
 
+type SyslogTuningSpec struct {
+	// Buffer configures the buffer where records are stored before they are sent to the output.
+	//
+	// +kubebuilder:validation:Optional
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Buffer"
+	Buffer *OutputBufferSpec `json:"buffer,omitempty"`
+}
+
 // Syslog provides optional extra properties for output type `syslog`
 type Syslog struct {
 
@@ -1012,6 +1347,13 @@ type Syslog struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Syslog RFC"
 	RFC SyslogRFCType `json:"rfc"`
 
+	// Tuning specs tuning for the output
+	//
+	// +kubebuilder:validation:Optional
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tuning Options"
+	Tuning *SyslogTuningSpec `json:"tuning,omitempty"`
+
 	// Severity to set on outgoing syslog records.
 	//
 	// Severity values are defined in https://tools.ietf.org/html/rfc5424#section-6.2.1
@@ -1163,21 +1505,58 @@ type OTLPTuningSpec struct {
 	Compression string `json:"compression,omitempty"`
 }
 
+// OTLPProtocol is the transport used to send OTLP requests
+//
+// +kubebuilder:validation:Enum:=grpc;http
+type OTLPProtocol string
+
+const (
+	OTLPProtocolGRPC OTLPProtocol = "grpc"
+	OTLPProtocolHTTP OTLPProtocol = "http"
+)
+
+// OTLPEncoding is the encoding of OTLP/HTTP requests
+//
+// +kubebuilder:validation:Enum:=json;protobuf
+type OTLPEncoding string
+
+const (
+	OTLPEncodingJSON     OTLPEncoding = "json"
+	OTLPEncodingProtobuf OTLPEncoding = "protobuf"
+)
+
 // OTLP defines configuration for sending logs via OTLP using OTEL semantic conventions
 // https://opentelemetry.io/docs/specs/otlp/#otlphttp
+//
+// +kubebuilder:validation:XValidation:rule="(has(self.protocol) && self.protocol == 'grpc') || self.url.endsWith('/v1/logs')", message="url must terminate with '/v1/logs' when protocol is http"
+// +kubebuilder:validation:XValidation:rule="!has(self.protocol) || self.protocol != 'grpc' || !has(self.encoding) || self.encoding == 'protobuf'", message="encoding must be protobuf when protocol is grpc"
 type OTLP struct {
 	// URL to send log records to.
 	//
-	// An absolute URL, with a valid http scheme. Must terminate with `/v1/logs`
+	// An absolute URL, with a valid http scheme. Must terminate with `/v1/logs` when the protocol is `http`.
+	// The path is ignored when the protocol is `grpc`
 	//
 	// Basic TLS is enabled if the URL scheme requires it (for example 'https').
 	// The 'username@password' part of `url` is ignored.
 	//
-	// +kubebuilder:validation:Pattern:=`^(https?):\/\/\S+\/v1\/logs$`
+	// +kubebuilder:validation:Pattern:=`^(https?):\/\/\S+$`
 	// +kubebuilder:validation:XValidation:rule="isURL(self)", message="invalid URL"
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Destination URL",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	URL string `json:"url"`
 
+	// Protocol is the transport of the OTLP requests
+	//
+	// +kubebuilder:default:=http
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Protocol",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:grpc","urn:alm:descriptor:com.tectonic.ui:select:http"}
+	Protocol OTLPProtocol `json:"protocol,omitempty"`
+
+	// Encoding of the OTLP/HTTP request payload. Defaults to json.  OTLP/gRPC requests are always encoded using protobuf
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Encoding",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:json","urn:alm:descriptor:com.tectonic.ui:select:protobuf"}
+	Encoding OTLPEncoding `json:"encoding,omitempty"`
+
 	// Authentication sets credentials for authenticating the requests.
 	//
 	// +kubebuilder:validation:Optional
@@ -22,6 +22,11 @@ func (in *Application) DeepCopyInto(out *Application) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tuning != nil {
 		in, out := &in.Tuning, &out.Tuning
 		*out = new(ContainerInputTuningSpec)
@@ -132,6 +137,11 @@ func (in *BaseOutputTuningSpec) DeepCopyInto(out *BaseOutputTuningSpec) {
 		*out = new(timex.Duration)
 		**out = **in
 	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(OutputBufferSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BaseOutputTuningSpec.
@@ -179,6 +189,26 @@ func (in *BearerTokenSecretKey) DeepCopy() *BearerTokenSecretKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSVParserSpec) DeepCopyInto(out *CSVParserSpec) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSVParserSpec.
+func (in *CSVParserSpec) DeepCopy() *CSVParserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CSVParserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cloudwatch) DeepCopyInto(out *Cloudwatch) {
 	*out = *in
@@ -353,6 +383,11 @@ func (in *ClusterLogForwarderSpec) DeepCopyInto(out *ClusterLogForwarderSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.InfrastructureNamespaces != nil {
+		in, out := &in.InfrastructureNamespaces, &out.InfrastructureNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Outputs != nil {
 		in, out := &in.Outputs, &out.Outputs
 		*out = make([]OutputSpec, len(*in))
@@ -459,6 +494,11 @@ func (in *CollectorSpec) DeepCopyInto(out *CollectorSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ForwarderDiskBufferBudget != nil {
+		in, out := &in.ForwarderDiskBufferBudget, &out.ForwarderDiskBufferBudget
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorSpec.
@@ -471,6 +511,21 @@ func (in *CollectorSpec) DeepCopy() *CollectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapReference) DeepCopyInto(out *ConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapReference.
+func (in *ConfigMapReference) DeepCopy() *ConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerInputTuningSpec) DeepCopyInto(out *ContainerInputTuningSpec) {
 	*out = *in
@@ -491,9 +546,59 @@ func (in *ContainerInputTuningSpec) DeepCopy() *ContainerInputTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DetectMultilineExceptionFilterSpec) DeepCopyInto(out *DetectMultilineExceptionFilterSpec) {
+	*out = *in
+	if in.Languages != nil {
+		in, out := &in.Languages, &out.Languages
+		*out = make([]MultilineLanguage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]MultilineRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DetectMultilineExceptionFilterSpec.
+func (in *DetectMultilineExceptionFilterSpec) DeepCopy() *DetectMultilineExceptionFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DetectMultilineExceptionFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DropCondition) DeepCopyInto(out *DropCondition) {
 	*out = *in
+	if in.Exists != nil {
+		in, out := &in.Exists, &out.Exists
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Equals != nil {
+		in, out := &in.Equals, &out.Equals
+		*out = new(string)
+		**out = **in
+	}
+	if in.In != nil {
+		in, out := &in.In, &out.In
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GreaterThan != nil {
+		in, out := &in.GreaterThan, &out.GreaterThan
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LessThan != nil {
+		in, out := &in.LessThan, &out.LessThan
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DropCondition.
@@ -512,7 +617,9 @@ func (in *DropTest) DeepCopyInto(out *DropTest) {
 	if in.DropConditions != nil {
 		in, out := &in.DropConditions, &out.DropConditions
 		*out = make([]DropCondition, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -568,6 +675,77 @@ func (in *ElasticsearchTuningSpec) DeepCopy() *ElasticsearchTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnrichFilterSpec) DeepCopyInto(out *EnrichFilterSpec) {
+	*out = *in
+	out.Table = in.Table
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnrichFilterSpec.
+func (in *EnrichFilterSpec) DeepCopy() *EnrichFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EnrichFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Events) DeepCopyInto(out *Events) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeNamespaces != nil {
+		in, out := &in.ExcludeNamespaces, &out.ExcludeNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Events.
+func (in *Events) DeepCopy() *Events {
+	if in == nil {
+		return nil
+	}
+	out := new(Events)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverSpec) DeepCopyInto(out *FailoverSpec) {
+	*out = *in
+	if in.OutputRefs != nil {
+		in, out := &in.OutputRefs, &out.OutputRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnavailableDuration != nil {
+		in, out := &in.UnavailableDuration, &out.UnavailableDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverSpec.
+func (in *FailoverSpec) DeepCopy() *FailoverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
 	*out = *in
@@ -588,6 +766,46 @@ func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
 		*out = new(PruneFilterSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ParseFilterSpec != nil {
+		in, out := &in.ParseFilterSpec, &out.ParseFilterSpec
+		*out = new(ParseFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MutateFilterSpec != nil {
+		in, out := &in.MutateFilterSpec, &out.MutateFilterSpec
+		*out = make([]MutateOperation, len(*in))
+		copy(*out, *in)
+	}
+	if in.RedactFilterSpec != nil {
+		in, out := &in.RedactFilterSpec, &out.RedactFilterSpec
+		*out = new(RedactFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DetectMultilineExceptionSpec != nil {
+		in, out := &in.DetectMultilineExceptionSpec, &out.DetectMultilineExceptionSpec
+		*out = new(DetectMultilineExceptionFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnrichFilterSpec != nil {
+		in, out := &in.EnrichFilterSpec, &out.EnrichFilterSpec
+		*out = new(EnrichFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricsFilterSpec != nil {
+		in, out := &in.MetricsFilterSpec, &out.MetricsFilterSpec
+		*out = new(MetricsFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SampleFilterSpec != nil {
+		in, out := &in.SampleFilterSpec, &out.SampleFilterSpec
+		*out = new(SampleFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThrottleFilterSpec != nil {
+		in, out := &in.ThrottleFilterSpec, &out.ThrottleFilterSpec
+		*out = new(ThrottleFilterSpec)
+		**out = **in
+	}
 	if in.OpenshiftLabels != nil {
 		in, out := &in.OpenshiftLabels, &out.OpenshiftLabels
 		*out = make(map[string]string, len(*in))
@@ -616,6 +834,11 @@ func (in *GoogleCloudLogging) DeepCopyInto(out *GoogleCloudLogging) {
 		(*in).DeepCopyInto(*out)
 	}
 	out.ID = in.ID
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(GoogleCloudLoggingResource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tuning != nil {
 		in, out := &in.Tuning, &out.Tuning
 		*out = new(GoogleCloudLoggingTuningSpec)
@@ -641,6 +864,11 @@ func (in *GoogleCloudLoggingAuthentication) DeepCopyInto(out *GoogleCloudLogging
 		*out = new(SecretReference)
 		**out = **in
 	}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(GoogleCloudLoggingWorkloadIdentity)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudLoggingAuthentication.
@@ -668,6 +896,28 @@ func (in *GoogleCloudLoggingId) DeepCopy() *GoogleCloudLoggingId {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudLoggingResource) DeepCopyInto(out *GoogleCloudLoggingResource) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudLoggingResource.
+func (in *GoogleCloudLoggingResource) DeepCopy() *GoogleCloudLoggingResource {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudLoggingResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GoogleCloudLoggingTuningSpec) DeepCopyInto(out *GoogleCloudLoggingTuningSpec) {
 	*out = *in
@@ -684,6 +934,37 @@ func (in *GoogleCloudLoggingTuningSpec) DeepCopy() *GoogleCloudLoggingTuningSpec
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudLoggingWorkloadIdentity) DeepCopyInto(out *GoogleCloudLoggingWorkloadIdentity) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudLoggingWorkloadIdentity.
+func (in *GoogleCloudLoggingWorkloadIdentity) DeepCopy() *GoogleCloudLoggingWorkloadIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudLoggingWorkloadIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrokParserSpec) DeepCopyInto(out *GrokParserSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrokParserSpec.
+func (in *GrokParserSpec) DeepCopy() *GrokParserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrokParserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTP) DeepCopyInto(out *HTTP) {
 	*out = *in
@@ -750,6 +1031,11 @@ func (in *HTTPAuthentication) DeepCopy() *HTTPAuthentication {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPReceiver) DeepCopyInto(out *HTTPReceiver) {
 	*out = *in
+	if in.FieldMappings != nil {
+		in, out := &in.FieldMappings, &out.FieldMappings
+		*out = new(HTTPReceiverFieldMappings)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPReceiver.
@@ -762,6 +1048,21 @@ func (in *HTTPReceiver) DeepCopy() *HTTPReceiver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPReceiverFieldMappings) DeepCopyInto(out *HTTPReceiverFieldMappings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPReceiverFieldMappings.
+func (in *HTTPReceiverFieldMappings) DeepCopy() *HTTPReceiverFieldMappings {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPReceiverFieldMappings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPTuningSpec) DeepCopyInto(out *HTTPTuningSpec) {
 	*out = *in
@@ -821,6 +1122,11 @@ func (in *InputSpec) DeepCopyInto(out *InputSpec) {
 		*out = new(ReceiverSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = new(Events)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputSpec.
@@ -926,6 +1232,11 @@ func (in *KafkaTuningSpec) DeepCopyInto(out *KafkaTuningSpec) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(OutputBufferSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTuningSpec.
@@ -938,6 +1249,21 @@ func (in *KafkaTuningSpec) DeepCopy() *KafkaTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyValueParserSpec) DeepCopyInto(out *KeyValueParserSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyValueParserSpec.
+func (in *KeyValueParserSpec) DeepCopy() *KeyValueParserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyValueParserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeApiAudit) DeepCopyInto(out *KubeApiAudit) {
 	*out = *in
@@ -1157,6 +1483,65 @@ func (in *LokiTuningSpec) DeepCopy() *LokiTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsFilterSpec) DeepCopyInto(out *MetricsFilterSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = make([]DropTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsFilterSpec.
+func (in *MetricsFilterSpec) DeepCopy() *MetricsFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultilineRule) DeepCopyInto(out *MultilineRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultilineRule.
+func (in *MultilineRule) DeepCopy() *MultilineRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MultilineRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MutateOperation) DeepCopyInto(out *MutateOperation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MutateOperation.
+func (in *MutateOperation) DeepCopy() *MutateOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(MutateOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceContainerSpec) DeepCopyInto(out *NamespaceContainerSpec) {
 	*out = *in
@@ -1197,6 +1582,21 @@ func (in *OTLP) DeepCopy() *OTLP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPReceiver) DeepCopyInto(out *OTLPReceiver) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPReceiver.
+func (in *OTLPReceiver) DeepCopy() *OTLPReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OTLPTuningSpec) DeepCopyInto(out *OTLPTuningSpec) {
 	*out = *in
@@ -1213,6 +1613,31 @@ func (in *OTLPTuningSpec) DeepCopy() *OTLPTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputBufferSpec) DeepCopyInto(out *OutputBufferSpec) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxEvents != nil {
+		in, out := &in.MaxEvents, &out.MaxEvents
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputBufferSpec.
+func (in *OutputBufferSpec) DeepCopy() *OutputBufferSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputBufferSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 	*out = *in
@@ -1266,6 +1691,11 @@ func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 		*out = new(LokiStack)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Splunk != nil {
 		in, out := &in.Splunk, &out.Splunk
 		*out = new(Splunk)
@@ -1274,7 +1704,7 @@ func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 	if in.Syslog != nil {
 		in, out := &in.Syslog, &out.Syslog
 		*out = new(Syslog)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.OTLP != nil {
 		in, out := &in.OTLP, &out.OTLP
@@ -1314,6 +1744,41 @@ func (in *OutputTLSSpec) DeepCopy() *OutputTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParseFilterSpec) DeepCopyInto(out *ParseFilterSpec) {
+	*out = *in
+	if in.Regex != nil {
+		in, out := &in.Regex, &out.Regex
+		*out = new(RegexParserSpec)
+		**out = **in
+	}
+	if in.KeyValue != nil {
+		in, out := &in.KeyValue, &out.KeyValue
+		*out = new(KeyValueParserSpec)
+		**out = **in
+	}
+	if in.CSV != nil {
+		in, out := &in.CSV, &out.CSV
+		*out = new(CSVParserSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Grok != nil {
+		in, out := &in.Grok, &out.Grok
+		*out = new(GrokParserSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParseFilterSpec.
+func (in *ParseFilterSpec) DeepCopy() *ParseFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ParseFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 	*out = *in
@@ -1327,6 +1792,11 @@ func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(FailoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FilterRefs != nil {
 		in, out := &in.FilterRefs, &out.FilterRefs
 		*out = make([]string, len(*in))
@@ -1380,6 +1850,16 @@ func (in *ReceiverSpec) DeepCopyInto(out *ReceiverSpec) {
 	if in.HTTP != nil {
 		in, out := &in.HTTP, &out.HTTP
 		*out = new(HTTPReceiver)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(SyslogReceiver)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLPReceiver)
 		**out = **in
 	}
 }
@@ -1394,6 +1874,117 @@ func (in *ReceiverSpec) DeepCopy() *ReceiverSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedactFilterSpec) DeepCopyInto(out *RedactFilterSpec) {
+	*out = *in
+	if in.Detectors != nil {
+		in, out := &in.Detectors, &out.Detectors
+		*out = make([]RedactDetector, len(*in))
+		copy(*out, *in)
+	}
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(RedactReplacement)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedactFilterSpec.
+func (in *RedactFilterSpec) DeepCopy() *RedactFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedactFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedactReplacement) DeepCopyInto(out *RedactReplacement) {
+	*out = *in
+	if in.Salt != nil {
+		in, out := &in.Salt, &out.Salt
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedactReplacement.
+func (in *RedactReplacement) DeepCopy() *RedactReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(RedactReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegexParserSpec) DeepCopyInto(out *RegexParserSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexParserSpec.
+func (in *RegexParserSpec) DeepCopy() *RegexParserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RegexParserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3) DeepCopyInto(out *S3) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(CloudwatchAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(S3TuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3.
+func (in *S3) DeepCopy() *S3 {
+	if in == nil {
+		return nil
+	}
+	out := new(S3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3TuningSpec) DeepCopyInto(out *S3TuningSpec) {
+	*out = *in
+	in.BaseOutputTuningSpec.DeepCopyInto(&out.BaseOutputTuningSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3TuningSpec.
+func (in *S3TuningSpec) DeepCopy() *S3TuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(S3TuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SASLAuthentication) DeepCopyInto(out *SASLAuthentication) {
 	*out = *in
@@ -1419,6 +2010,28 @@ func (in *SASLAuthentication) DeepCopy() *SASLAuthentication {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SampleFilterSpec) DeepCopyInto(out *SampleFilterSpec) {
+	*out = *in
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]DropTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SampleFilterSpec.
+func (in *SampleFilterSpec) DeepCopy() *SampleFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SampleFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretReference) DeepCopyInto(out *SecretReference) {
 	*out = *in
@@ -1463,6 +2076,11 @@ func (in *Splunk) DeepCopyInto(out *Splunk) {
 		(*in).DeepCopyInto(*out)
 	}
 	out.URLSpec = in.URLSpec
+	if in.IndexedFields != nil {
+		in, out := &in.IndexedFields, &out.IndexedFields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Splunk.
@@ -1514,6 +2132,11 @@ func (in *SplunkTuningSpec) DeepCopy() *SplunkTuningSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Syslog) DeepCopyInto(out *Syslog) {
 	*out = *in
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(SyslogTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Syslog.
@@ -1526,6 +2149,51 @@ func (in *Syslog) DeepCopy() *Syslog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogReceiver) DeepCopyInto(out *SyslogReceiver) {
+	*out = *in
+	if in.ClientCA != nil {
+		in, out := &in.ClientCA, &out.ClientCA
+		*out = new(ValueReference)
+		**out = **in
+	}
+	if in.MaxMessageSize != nil {
+		in, out := &in.MaxMessageSize, &out.MaxMessageSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogReceiver.
+func (in *SyslogReceiver) DeepCopy() *SyslogReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogTuningSpec) DeepCopyInto(out *SyslogTuningSpec) {
+	*out = *in
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(OutputBufferSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogTuningSpec.
+func (in *SyslogTuningSpec) DeepCopy() *SyslogTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
 	*out = *in
@@ -1561,6 +2229,21 @@ func (in *TLSSpec) DeepCopy() *TLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThrottleFilterSpec) DeepCopyInto(out *ThrottleFilterSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThrottleFilterSpec.
+func (in *ThrottleFilterSpec) DeepCopy() *ThrottleFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThrottleFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *URLSpec) DeepCopyInto(out *URLSpec) {
 	*out = *in
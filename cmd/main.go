@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"time"
@@ -23,6 +24,8 @@ import (
 	"github.com/openshift/cluster-logging-operator/api/logging/v1alpha1"
 	observabilityv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	observabilitycontroller "github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/eventwatcher"
+	observabilitywebhook "github.com/openshift/cluster-logging-operator/internal/webhook/observability"
 
 	log "github.com/ViaQ/logerr/v2/log/static"
 
@@ -75,6 +78,15 @@ func init() {
 }
 
 func main() {
+	// the operator image also runs the event watcher of events inputs
+	if len(os.Args) > 1 && os.Args[1] == eventwatcher.Command {
+		if err := eventwatcher.Main(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
@@ -177,6 +189,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	// The validating webhook is only served by OLM deployments which provide the serving certificates
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" && webhookCertificateProvided() {
+		if err = (&observabilitywebhook.ClusterLogForwarderValidator{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			log.Error(err, "unable to create webhook", "webhook", "observability.ClusterLogForwarder")
+			os.Exit(1)
+		}
+	}
+
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -212,6 +234,13 @@ func getWatchNS() []string {
 	return strings.Split(ns, ",")
 }
 
+// webhookCertificateProvided returns true when the serving certificate of the webhook server is mounted
+// in its default location, which is where OLM provides it
+func webhookCertificateProvided() bool {
+	_, err := os.Stat(filepath.Join(os.TempDir(), "k8s-webhook-server", "serving-certs", "tls.crt"))
+	return err == nil
+}
+
 func migrateManifestResources(k8sClient client.Client) {
 	log.Info("migrating resources provided by the manifest")
 	if err := k8sClient.Delete(context.TODO(), loggingruntime.NewPriorityClass("cluster-logging", 0, false, "")); err != nil && !errors.IsNotFound(err) {
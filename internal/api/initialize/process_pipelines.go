@@ -94,7 +94,8 @@ func getInputTypeFromName(spec obs.ClusterLogForwarderSpec, inputName string) st
 
 	for _, input := range spec.Inputs {
 		if input.Name == inputName {
-			if input.Application != nil {
+			if input.Application != nil || (input.Receiver != nil && input.Receiver.Type == obs.ReceiverTypeOTLP) ||
+				(input.Receiver != nil && input.Receiver.Type == obs.ReceiverTypeHTTP && input.Receiver.HTTP != nil && input.Receiver.HTTP.Format != obs.HTTPReceiverFormatKubeApiAudit) {
 				return string(obs.InputTypeApplication)
 			}
 			if input.Infrastructure != nil || input.Receiver.Type == obs.ReceiverTypeSyslog {
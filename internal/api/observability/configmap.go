@@ -1,6 +1,12 @@
 package observability
 
-import corev1 "k8s.io/api/core/v1"
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+)
 
 type ConfigMaps map[string]*corev1.ConfigMap
 
@@ -10,3 +16,25 @@ func (c ConfigMaps) Names() (names []string) {
 	}
 	return names
 }
+
+// Hash64a returns an FNV-1a representation of the configmaps
+func (c ConfigMaps) Hash64a() string {
+	names := c.Names()
+	sort.Strings(names)
+	buffer := fnv.New64a()
+	for _, name := range names {
+		buffer.Write([]byte(name))
+
+		var keys []string
+		for key := range c[name].Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			buffer.Write([]byte(k))
+			buffer.Write([]byte(c[name].Data[k]))
+		}
+	}
+	return fmt.Sprintf("%d", buffer.Sum64())
+}
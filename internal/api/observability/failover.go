@@ -0,0 +1,58 @@
+package observability
+
+import (
+	"time"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultFailoverUnavailableDuration is the time an output must be unavailable before its failover group fails over
+const DefaultFailoverUnavailableDuration = 5 * time.Minute
+
+// OutputRefs returns the names of the outputs referenced by a pipeline, including the outputs of its failover group
+func OutputRefs(p obs.PipelineSpec) []string {
+	refs := append([]string{}, p.OutputRefs...)
+	if p.Failover != nil {
+		refs = append(refs, p.Failover.OutputRefs...)
+	}
+	return refs
+}
+
+// FailoverOutputNames returns the names of the outputs that are members of a failover group of any pipeline
+func (pipeline Pipelines) FailoverOutputNames() (names []string) {
+	for _, p := range pipeline {
+		if p.Failover != nil {
+			names = append(names, p.Failover.OutputRefs...)
+		}
+	}
+	return names
+}
+
+// FailoverUnavailableDuration returns the time an output of the failover group of a pipeline must be unavailable before
+// the group fails over
+func FailoverUnavailableDuration(p obs.PipelineSpec) time.Duration {
+	if p.Failover != nil && p.Failover.UnavailableDuration != nil {
+		return p.Failover.UnavailableDuration.Duration
+	}
+	return DefaultFailoverUnavailableDuration
+}
+
+// ActiveFailoverOutput returns the active output of the failover group of a pipeline given the availability
+// conditions of the outputs.  It is the first output in the group that has not been unavailable longer than the
+// unavailable duration or the primary output when all outputs are unavailable
+func ActiveFailoverOutput(p obs.PipelineSpec, outputConditions []metav1.Condition) string {
+	if p.Failover == nil || len(p.Failover.OutputRefs) == 0 {
+		return ""
+	}
+	threshold := FailoverUnavailableDuration(p)
+	now := clock.Now()
+	for _, ref := range p.Failover.OutputRefs {
+		cond := meta.FindStatusCondition(outputConditions, obs.ConditionTypeAvailableOutputPrefix+"-"+ref)
+		if cond == nil || cond.Status != obs.ConditionFalse || now.Sub(cond.LastTransitionTime.Time) < threshold {
+			return ref
+		}
+	}
+	return p.Failover.OutputRefs[0]
+}
@@ -0,0 +1,60 @@
+package observability
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("#ActiveFailoverOutput", func() {
+
+	var (
+		pipeline = obs.PipelineSpec{
+			Name: "mypipeline",
+			Failover: &obs.FailoverSpec{
+				OutputRefs:          []string{"loki", "s3", "kafka"},
+				UnavailableDuration: &metav1.Duration{Duration: time.Minute},
+			},
+		}
+		unavailable = func(name string, since time.Duration) metav1.Condition {
+			cond := NewConditionFromPrefix(obs.ConditionTypeAvailableOutputPrefix, name, false, obs.ReasonOutputUnavailable, "")
+			cond.LastTransitionTime = metav1.NewTime(time.Now().Add(-since))
+			return cond
+		}
+	)
+
+	It("should be empty for a pipeline without a failover group", func() {
+		Expect(ActiveFailoverOutput(obs.PipelineSpec{}, nil)).To(BeEmpty())
+	})
+
+	It("should be the primary output when the availability is unknown", func() {
+		Expect(ActiveFailoverOutput(pipeline, nil)).To(Equal("loki"))
+	})
+
+	It("should be the primary output when it is unavailable for less than the unavailable duration", func() {
+		Expect(ActiveFailoverOutput(pipeline, []metav1.Condition{unavailable("loki", 30*time.Second)})).To(Equal("loki"))
+	})
+
+	It("should fail over to the next output when the primary is unavailable longer than the unavailable duration", func() {
+		Expect(ActiveFailoverOutput(pipeline, []metav1.Condition{unavailable("loki", 2*time.Minute)})).To(Equal("s3"))
+	})
+
+	It("should fail over to the first output that is not unavailable", func() {
+		conditions := []metav1.Condition{unavailable("loki", 2*time.Minute), unavailable("s3", 2*time.Minute)}
+		Expect(ActiveFailoverOutput(pipeline, conditions)).To(Equal("kafka"))
+	})
+
+	It("should be the primary output when all outputs are unavailable", func() {
+		conditions := []metav1.Condition{unavailable("loki", 2*time.Minute), unavailable("s3", 2*time.Minute), unavailable("kafka", 2*time.Minute)}
+		Expect(ActiveFailoverOutput(pipeline, conditions)).To(Equal("loki"))
+	})
+
+	It("should default the unavailable duration", func() {
+		p := *pipeline.DeepCopy()
+		p.Failover.UnavailableDuration = nil
+		Expect(ActiveFailoverOutput(p, []metav1.Condition{unavailable("loki", 2*time.Minute)})).To(Equal("loki"))
+	})
+})
@@ -1,6 +1,9 @@
 package observability
 
-import obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"k8s.io/utils/set"
+)
 
 // FilterMap returns a map of filter names to FilterSpec.
 func FilterMap(spec obs.ClusterLogForwarderSpec) map[string]*obs.FilterSpec {
@@ -20,3 +23,41 @@ func (filters Filters) Names() (names []string) {
 	}
 	return names
 }
+
+// SecretNames returns a unique set of unordered secret names
+func (filters Filters) SecretNames() []string {
+	secrets := set.New[string]()
+	for _, ref := range filters.SecretReferences() {
+		secrets.Insert(ref.SecretName)
+	}
+	return secrets.UnsortedList()
+}
+
+// SecretReferences returns the secret keys referenced by the filters
+func (filters Filters) SecretReferences() (refs []*obs.SecretReference) {
+	for _, f := range filters {
+		if f.Type == obs.FilterTypeRedact && f.RedactFilterSpec != nil && f.RedactFilterSpec.Replacement != nil && f.RedactFilterSpec.Replacement.Salt != nil {
+			refs = append(refs, f.RedactFilterSpec.Replacement.Salt)
+		}
+	}
+	return refs
+}
+
+// ConfigmapNames returns a unique set of unordered configmap names
+func (filters Filters) ConfigmapNames() []string {
+	names := set.New[string]()
+	for _, ref := range filters.ConfigMapReferences() {
+		names.Insert(ref.ConfigMapName)
+	}
+	return names.UnsortedList()
+}
+
+// ConfigMapReferences returns the configmap keys referenced by the filters
+func (filters Filters) ConfigMapReferences() (refs []*obs.ConfigMapReference) {
+	for i, f := range filters {
+		if f.Type == obs.FilterTypeEnrich && f.EnrichFilterSpec != nil {
+			refs = append(refs, &filters[i].EnrichFilterSpec.Table)
+		}
+	}
+	return refs
+}
@@ -34,16 +34,17 @@ func IsValid(forwarder obs.ClusterLogForwarder) bool {
 }
 
 func isValid(prefix string, conditions []metav1.Condition, expConditions int) bool {
-	if len(conditions) != expConditions {
-		return false
-	}
+	found := 0
 	conditionTrue := 0
 	for _, cond := range conditions {
-		if strings.HasPrefix(cond.Type, prefix) && cond.Status == obs.ConditionTrue {
-			conditionTrue++
+		if strings.HasPrefix(cond.Type, prefix) {
+			found++
+			if cond.Status == obs.ConditionTrue {
+				conditionTrue++
+			}
 		}
 	}
-	return conditionTrue == expConditions
+	return found == expConditions && conditionTrue == expConditions
 }
 
 func isAuthorized(conditions []metav1.Condition) bool {
@@ -72,6 +72,13 @@ var _ = Describe("[internal][api][observability]", func() {
 			}
 			Expect(IsValid(forwarder)).To(BeFalse())
 		})
+		It("should ignore conditions other than validation conditions", func() {
+			forwarder.Status.OutputConditions = append(forwarder.Status.OutputConditions,
+				NewConditionFromPrefix(obs.ConditionTypeAvailableOutputPrefix, "foo", false, "", ""))
+			forwarder.Status.PipelineConditions = append(forwarder.Status.PipelineConditions,
+				NewConditionFromPrefix(obs.ConditionTypeFailoverPrefix, "foo", true, "", ""))
+			Expect(IsValid(forwarder)).To(BeTrue())
+		})
 		It("should be false when the forwarder is not authorized", func() {
 			forwarder.Status.Conditions = []metav1.Condition{
 				NewCondition(obs.ConditionTypeAuthorized, obs.ConditionFalse, "", ""),
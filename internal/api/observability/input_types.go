@@ -1,6 +1,8 @@
 package observability
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/set"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
@@ -13,6 +15,65 @@ var ReservedInputTypes = sets.NewString(
 	string(obs.InputTypeInfrastructure),
 )
 
+// DefaultOTLPReceiverGRPCPort is the OTLP/gRPC port of an OTLP receiver that does not spec one
+const DefaultOTLPReceiverGRPCPort int32 = 4317
+
+// ReceiverPorts returns the ports a receiver listens on.  The ports are named when a receiver listens on more than one
+func ReceiverPorts(spec obs.ReceiverSpec) []corev1.ServicePort {
+	switch {
+	case spec.Type == obs.ReceiverTypeOTLP:
+		return []corev1.ServicePort{
+			receiverPort("otlp-http", spec.Port, corev1.ProtocolTCP),
+			receiverPort("otlp-grpc", OTLPReceiverGRPCPort(spec), corev1.ProtocolTCP),
+		}
+	case spec.Type == obs.ReceiverTypeSyslog && SyslogReceiverProtocol(spec) == obs.SyslogReceiverProtocolUDP:
+		return []corev1.ServicePort{receiverPort("", spec.Port, corev1.ProtocolUDP)}
+	case spec.Type == obs.ReceiverTypeSyslog && SyslogReceiverProtocol(spec) == obs.SyslogReceiverProtocolBoth:
+		return []corev1.ServicePort{
+			receiverPort("syslog-tcp", spec.Port, corev1.ProtocolTCP),
+			receiverPort("syslog-udp", spec.Port, corev1.ProtocolUDP),
+		}
+	}
+	return []corev1.ServicePort{receiverPort("", spec.Port, corev1.ProtocolTCP)}
+}
+
+func receiverPort(name string, port int32, protocol corev1.Protocol) corev1.ServicePort {
+	return corev1.ServicePort{
+		Name:       name,
+		Port:       port,
+		TargetPort: intstr.FromInt32(port),
+		Protocol:   protocol,
+	}
+}
+
+// SyslogReceiverProtocol returns the transport protocol of a syslog receiver
+func SyslogReceiverProtocol(spec obs.ReceiverSpec) obs.SyslogReceiverProtocol {
+	if spec.Syslog != nil && spec.Syslog.Protocol != "" {
+		return spec.Syslog.Protocol
+	}
+	return obs.SyslogReceiverProtocolTCP
+}
+
+// ReceiverTLS returns the TLS spec of a receiver where the CA verifies the certificates of clients if spec'd
+func ReceiverTLS(spec obs.ReceiverSpec) *obs.TLSSpec {
+	if spec.TLS == nil {
+		return nil
+	}
+	tlsSpec := obs.TLSSpec(*spec.TLS)
+	if spec.Syslog != nil && spec.Syslog.ClientCA != nil {
+		tlsSpec.CA = spec.Syslog.ClientCA
+	}
+	return &tlsSpec
+}
+
+// OTLPReceiverGRPCPort returns the OTLP/gRPC port of an OTLP receiver
+func OTLPReceiverGRPCPort(spec obs.ReceiverSpec) int32 {
+	if spec.OTLP != nil && spec.OTLP.GRPCPort != 0 {
+		return spec.OTLP.GRPCPort
+	}
+	return DefaultOTLPReceiverGRPCPort
+}
+
 func MaxRecordsPerSecond(input obs.InputSpec) (int64, bool) {
 	if input.Application != nil &&
 		input.Application.Tuning != nil &&
@@ -62,7 +123,7 @@ func (inputs Inputs) ConfigmapNames() []string {
 	names := set.New[string]()
 	for _, i := range inputs {
 		if i.Receiver != nil && i.Receiver.TLS != nil {
-			names.Insert(ConfigmapsForTLS(obs.TLSSpec(*i.Receiver.TLS))...)
+			names.Insert(ConfigmapsForTLS(*ReceiverTLS(*i.Receiver))...)
 		}
 	}
 	return names.UnsortedList()
@@ -73,7 +134,7 @@ func (inputs Inputs) SecretNames() []string {
 	secrets := set.New[string]()
 	for _, i := range inputs {
 		if i.Receiver != nil && i.Receiver.TLS != nil {
-			secrets.Insert(SecretsForTLS(obs.TLSSpec(*i.Receiver.TLS))...)
+			secrets.Insert(SecretsForTLS(*ReceiverTLS(*i.Receiver))...)
 		}
 	}
 	return secrets.UnsortedList()
@@ -88,9 +149,10 @@ func (inputs Inputs) HasJournalSource() bool {
 	return false
 }
 
+// HasContainerSource returns true if any input reads container logs. Events are read from the logs of the event watcher
 func (inputs Inputs) HasContainerSource() bool {
 	for _, i := range inputs {
-		if i.Type == obs.InputTypeApplication {
+		if i.Type == obs.InputTypeApplication || i.Type == obs.InputTypeEvents {
 			return true
 		}
 		if i.Type == obs.InputTypeInfrastructure && i.Infrastructure != nil && (len(i.Infrastructure.Sources) == 0 || set.New(i.Infrastructure.Sources...).Has(obs.InfrastructureSourceContainer)) {
@@ -125,3 +187,12 @@ func (inputs Inputs) HasReceiverSource() bool {
 	}
 	return false
 }
+
+func (inputs Inputs) HasEventsSource() bool {
+	for _, i := range inputs {
+		if i.Type == obs.InputTypeEvents {
+			return true
+		}
+	}
+	return false
+}
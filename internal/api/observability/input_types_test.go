@@ -0,0 +1,59 @@
+package observability_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("helpers for input types", func() {
+
+	DescribeTable("#ReceiverPorts", func(spec obsv1.ReceiverSpec, exp map[string]corev1.Protocol) {
+		ports := map[string]corev1.Protocol{}
+		for _, p := range ReceiverPorts(spec) {
+			Expect(p.TargetPort.IntVal).To(Equal(p.Port))
+			ports[p.Name] = p.Protocol
+		}
+		Expect(ports).To(Equal(exp))
+	},
+		Entry("should expose a single TCP port for an HTTP receiver",
+			obsv1.ReceiverSpec{Type: obsv1.ReceiverTypeHTTP, Port: 8443},
+			map[string]corev1.Protocol{"": corev1.ProtocolTCP}),
+		Entry("should expose a single TCP port for a syslog receiver by default",
+			obsv1.ReceiverSpec{Type: obsv1.ReceiverTypeSyslog, Port: 10514},
+			map[string]corev1.Protocol{"": corev1.ProtocolTCP}),
+		Entry("should expose a single UDP port for a syslog receiver over udp",
+			obsv1.ReceiverSpec{Type: obsv1.ReceiverTypeSyslog, Port: 10514, Syslog: &obsv1.SyslogReceiver{Protocol: obsv1.SyslogReceiverProtocolUDP}},
+			map[string]corev1.Protocol{"": corev1.ProtocolUDP}),
+		Entry("should expose named TCP and UDP ports for a syslog receiver over both",
+			obsv1.ReceiverSpec{Type: obsv1.ReceiverTypeSyslog, Port: 10514, Syslog: &obsv1.SyslogReceiver{Protocol: obsv1.SyslogReceiverProtocolBoth}},
+			map[string]corev1.Protocol{"syslog-tcp": corev1.ProtocolTCP, "syslog-udp": corev1.ProtocolUDP}),
+		Entry("should expose named HTTP and gRPC ports for an OTLP receiver",
+			obsv1.ReceiverSpec{Type: obsv1.ReceiverTypeOTLP, Port: 4318},
+			map[string]corev1.Protocol{"otlp-http": corev1.ProtocolTCP, "otlp-grpc": corev1.ProtocolTCP}),
+	)
+
+	Context("#ReceiverTLS", func() {
+		var (
+			ca       = &obsv1.ValueReference{Key: "ca.crt", ConfigMapName: "server-ca"}
+			clientCA = &obsv1.ValueReference{Key: "ca.crt", ConfigMapName: "client-ca"}
+		)
+		It("should return nil when TLS is not spec'd", func() {
+			Expect(ReceiverTLS(obsv1.ReceiverSpec{})).To(BeNil())
+		})
+		It("should return the TLS spec of the receiver", func() {
+			Expect(ReceiverTLS(obsv1.ReceiverSpec{TLS: &obsv1.InputTLSSpec{CA: ca}}).CA).To(Equal(ca))
+		})
+		It("should use the client CA of a syslog receiver", func() {
+			spec := obsv1.ReceiverSpec{
+				TLS:    &obsv1.InputTLSSpec{},
+				Syslog: &obsv1.SyslogReceiver{ClientCA: clientCA},
+			}
+			Expect(ReceiverTLS(spec).CA).To(Equal(clientCA))
+		})
+	})
+
+})
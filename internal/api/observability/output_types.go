@@ -31,6 +31,32 @@ func (outputs Outputs) Map() map[string]obsv1.OutputSpec {
 	return m
 }
 
+// URL returns the endpoint URL of an output or an empty string for outputs that do not require an
+// endpoint (e.g. Amazon CloudWatch or Google Cloud Logging)
+func URL(o obsv1.OutputSpec) string {
+	switch {
+	case o.Type == obsv1.OutputTypeCloudwatch && o.Cloudwatch != nil:
+		return o.Cloudwatch.URL
+	case o.Type == obsv1.OutputTypeElasticsearch && o.Elasticsearch != nil:
+		return o.Elasticsearch.URL
+	case o.Type == obsv1.OutputTypeHTTP && o.HTTP != nil:
+		return o.HTTP.URL
+	case o.Type == obsv1.OutputTypeKafka && o.Kafka != nil:
+		return o.Kafka.URL
+	case o.Type == obsv1.OutputTypeLoki && o.Loki != nil:
+		return o.Loki.URL
+	case o.Type == obsv1.OutputTypeS3 && o.S3 != nil:
+		return o.S3.URL
+	case o.Type == obsv1.OutputTypeSplunk && o.Splunk != nil:
+		return o.Splunk.URL
+	case o.Type == obsv1.OutputTypeSyslog && o.Syslog != nil:
+		return o.Syslog.URL
+	case o.Type == obsv1.OutputTypeOTLP && o.OTLP != nil:
+		return o.OTLP.URL
+	}
+	return ""
+}
+
 // ConfigmapNames returns a unique set of unordered configmap names
 func (outputs Outputs) ConfigmapNames() []string {
 	names := set.New[string]()
@@ -53,6 +79,11 @@ func (outputs Outputs) NeedServiceAccountToken() bool {
 			auths = append(auths, o.LokiStack.Authentication.Token)
 		case o.Type == obsv1.OutputTypeCloudwatch && o.Cloudwatch != nil && o.Cloudwatch.Authentication.Type == obsv1.CloudwatchAuthTypeIAMRole:
 			auths = append(auths, &o.Cloudwatch.Authentication.IAMRole.Token)
+		case o.Type == obsv1.OutputTypeS3 && o.S3 != nil && o.S3.Authentication.Type == obsv1.CloudwatchAuthTypeIAMRole:
+			auths = append(auths, &o.S3.Authentication.IAMRole.Token)
+		case o.Type == obsv1.OutputTypeGoogleCloudLogging && o.GoogleCloudLogging != nil && o.GoogleCloudLogging.Authentication != nil &&
+			o.GoogleCloudLogging.Authentication.WorkloadIdentity != nil:
+			auths = append(auths, &o.GoogleCloudLogging.Authentication.WorkloadIdentity.Token)
 		case o.Type == obsv1.OutputTypeElasticsearch && o.Elasticsearch != nil && o.Elasticsearch.Authentication != nil && o.Elasticsearch.Authentication.Token != nil:
 			auths = append(auths, o.Elasticsearch.Authentication.Token)
 		case o.Type == obsv1.OutputTypeOTLP && o.OTLP.Authentication != nil && o.OTLP.Authentication.Token != nil:
@@ -117,7 +148,14 @@ func SecretReferences(o obsv1.OutputSpec) []*obsv1.SecretReference {
 	case obsv1.OutputTypeGoogleCloudLogging:
 		if o.GoogleCloudLogging != nil && o.GoogleCloudLogging.Authentication != nil {
 			a := o.GoogleCloudLogging.Authentication
-			return []*obsv1.SecretReference{a.Credentials}
+			keys := []*obsv1.SecretReference{a.Credentials}
+			if a.WorkloadIdentity != nil && a.WorkloadIdentity.Token.From == obsv1.BearerTokenFromSecret && a.WorkloadIdentity.Token.Secret != nil {
+				keys = append(keys, &obsv1.SecretReference{
+					Key:        a.WorkloadIdentity.Token.Secret.Key,
+					SecretName: a.WorkloadIdentity.Token.Secret.Name,
+				})
+			}
+			return keys
 		}
 	case obsv1.OutputTypeHTTP:
 		if o.HTTP != nil && o.HTTP.Authentication != nil {
@@ -140,6 +178,10 @@ func SecretReferences(o obsv1.OutputSpec) []*obsv1.SecretReference {
 		if o.LokiStack != nil {
 			return lokiStackKeys(o.LokiStack.Authentication)
 		}
+	case obsv1.OutputTypeS3:
+		if o.S3 != nil && o.S3.Authentication != nil {
+			return cloudwatchAuthKeys(o.S3.Authentication)
+		}
 	case obsv1.OutputTypeSplunk:
 		if o.Splunk != nil && o.Splunk.Authentication != nil {
 			return []*obsv1.SecretReference{o.Splunk.Authentication.Token}
@@ -2,6 +2,18 @@ package observability
 
 import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// MinDiskBufferSize is the smallest disk buffer supported by the collector
+	MinDiskBufferSize = 268435488
+)
+
+var (
+	// DefaultForwarderDiskBufferBudget is the disk space on a node available to the disk buffers of a forwarder when
+	// the budget is not spec'd
+	DefaultForwarderDiskBufferBudget = resource.MustParse("10Gi")
 )
 
 type Tuning struct {
@@ -44,6 +56,7 @@ func NewTuning(spec obs.OutputSpec) Tuning {
 		if spec.Kafka != nil && spec.Kafka.Tuning != nil {
 			t.DeliveryMode = spec.Kafka.Tuning.DeliveryMode
 			t.MaxWrite = spec.Kafka.Tuning.MaxWrite
+			t.Buffer = spec.Kafka.Tuning.Buffer
 			t.Compression = spec.Kafka.Tuning.Compression
 		}
 	case obs.OutputTypeLoki:
@@ -56,11 +69,95 @@ func NewTuning(spec obs.OutputSpec) Tuning {
 			t.BaseOutputTuningSpec = spec.LokiStack.Tuning.BaseOutputTuningSpec
 			t.Compression = spec.LokiStack.Tuning.Compression
 		}
+	case obs.OutputTypeS3:
+		if spec.S3 != nil && spec.S3.Tuning != nil {
+			t.BaseOutputTuningSpec = spec.S3.Tuning.BaseOutputTuningSpec
+			t.Compression = spec.S3.Tuning.Compression
+		}
 	case obs.OutputTypeSplunk:
 		if spec.Splunk != nil && spec.Splunk.Tuning != nil {
 			t.BaseOutputTuningSpec = spec.Splunk.Tuning.BaseOutputTuningSpec
 			t.Compression = spec.Splunk.Tuning.Compression
 		}
+	case obs.OutputTypeSyslog:
+		if spec.Syslog != nil && spec.Syslog.Tuning != nil {
+			t.Buffer = spec.Syslog.Tuning.Buffer
+		}
 	}
 	return t
 }
+
+// DiskBufferSize returns the number of bytes reserved on the node by the disk buffer of the output
+func (t Tuning) DiskBufferSize() int64 {
+	if t.Buffer != nil {
+		if t.Buffer.Type == obs.BufferTypeDisk && t.Buffer.MaxSize != nil {
+			return t.Buffer.MaxSize.Value()
+		}
+		return 0
+	}
+	if t.DeliveryMode == obs.DeliveryModeAtLeastOnce {
+		return MinDiskBufferSize
+	}
+	return 0
+}
+
+// DiskBufferSize returns the number of bytes reserved on the node by the disk buffers of all outputs
+func (outputs Outputs) DiskBufferSize() (size int64) {
+	for _, o := range outputs {
+		size += NewTuning(o).DiskBufferSize()
+	}
+	return size
+}
+
+// DiskBufferSizes returns the number of bytes reserved on the node by the disk buffers of the outputs deployed to the
+// collector by output name.  Outputs not referenced by a pipeline are not deployed.  Only the active output of a failover
+// group is deployed at a time so a group reserves the largest disk buffer of its outputs, which is attributed to that output
+func DiskBufferSizes(spec obs.ClusterLogForwarderSpec) map[string]int64 {
+	outputs := map[string]obs.OutputSpec{}
+	for _, o := range spec.Outputs {
+		outputs[o.Name] = o
+	}
+	sizes := map[string]int64{}
+	add := func(name string) {
+		if o, found := outputs[name]; found {
+			sizes[name] = NewTuning(o).DiskBufferSize()
+			if dl, found := outputs[o.TransformFailureOutputRef]; found {
+				sizes[dl.Name] = NewTuning(dl).DiskBufferSize()
+			}
+		}
+	}
+	for _, p := range spec.Pipelines {
+		for _, ref := range p.OutputRefs {
+			add(ref)
+		}
+	}
+	deployed := map[string]bool{}
+	for name := range sizes {
+		deployed[name] = true
+	}
+	for _, p := range spec.Pipelines {
+		if p.Failover == nil {
+			continue
+		}
+		var largest *obs.OutputSpec
+		for _, ref := range p.Failover.OutputRefs {
+			// outputs also referenced by the pipelines are deployed regardless of the group
+			if o, found := outputs[ref]; found && !deployed[ref] &&
+				(largest == nil || NewTuning(o).DiskBufferSize() > NewTuning(*largest).DiskBufferSize()) {
+				largest = &o
+			}
+		}
+		if largest != nil {
+			add(largest.Name)
+		}
+	}
+	return sizes
+}
+
+// ForwarderDiskBufferBudget returns the disk space on a node available to the disk buffers of the outputs of a forwarder
+func ForwarderDiskBufferBudget(spec *obs.CollectorSpec) resource.Quantity {
+	if spec != nil && spec.ForwarderDiskBufferBudget != nil {
+		return *spec.ForwarderDiskBufferBudget
+	}
+	return DefaultForwarderDiskBufferBudget
+}
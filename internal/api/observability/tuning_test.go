@@ -24,6 +24,10 @@ var _ = Describe("Output", func() {
 			MaxRetryDuration: utils.GetPtr(time.Second),
 			MinRetryDuration: utils.GetPtr(3 * time.Second),
 		}
+		diskBuffer = &obs.OutputBufferSpec{
+			Type:    obs.BufferTypeDisk,
+			MaxSize: utils.GetPtr(resource.MustParse("1Gi")),
+		}
 		kafkaBaseSpec = &obs.BaseOutputTuningSpec{
 			DeliveryMode: obs.DeliveryModeAtLeastOnce,
 			MaxWrite:     utils.GetPtr(resource.MustParse("1250G")),
@@ -60,6 +64,15 @@ var _ = Describe("Output", func() {
 				},
 			},
 		}, baseSpec, compression),
+		Entry("with S3", obs.OutputSpec{
+			Type: obs.OutputTypeS3,
+			S3: &obs.S3{
+				Tuning: &obs.S3TuningSpec{
+					BaseOutputTuningSpec: *baseSpec,
+					Compression:          compression,
+				},
+			},
+		}, baseSpec, compression),
 		Entry("with Elasticsearch", obs.OutputSpec{
 			Type: obs.OutputTypeElasticsearch,
 			Elasticsearch: &obs.Elasticsearch{
@@ -123,5 +136,29 @@ var _ = Describe("Output", func() {
 				},
 			},
 		}, baseSpec, ""),
+		Entry("with Syslog", obs.OutputSpec{
+			Type: obs.OutputTypeSyslog,
+			Syslog: &obs.Syslog{
+				Tuning: &obs.SyslogTuningSpec{
+					Buffer: diskBuffer,
+				},
+			},
+		}, &obs.BaseOutputTuningSpec{Buffer: diskBuffer}, ""),
+	)
+
+	DescribeTable("#DiskBufferSize", func(tuning obs.BaseOutputTuningSpec, exp int64) {
+		Expect(internalobs.NewTuning(obs.OutputSpec{
+			Type: obs.OutputTypeHTTP,
+			HTTP: &obs.HTTP{
+				Tuning: &obs.HTTPTuningSpec{
+					BaseOutputTuningSpec: tuning,
+				},
+			},
+		}).DiskBufferSize()).To(Equal(exp))
+	},
+		Entry("should be zero without a buffer", obs.BaseOutputTuningSpec{}, int64(0)),
+		Entry("should be the minimum size for atLeastOnce delivery", obs.BaseOutputTuningSpec{DeliveryMode: obs.DeliveryModeAtLeastOnce}, int64(internalobs.MinDiskBufferSize)),
+		Entry("should be the max size of a disk buffer", obs.BaseOutputTuningSpec{DeliveryMode: obs.DeliveryModeAtLeastOnce, Buffer: diskBuffer}, int64(1073741824)),
+		Entry("should be zero for a memory buffer", obs.BaseOutputTuningSpec{DeliveryMode: obs.DeliveryModeAtLeastOnce, Buffer: &obs.OutputBufferSpec{Type: obs.BufferTypeMemory}}, int64(0)),
 	)
 })
@@ -10,22 +10,32 @@ import (
 	v1 "k8s.io/api/core/v1"
 )
 
-// Add volumes and env vars if output type is cloudwatch and role is found in the secret
+// Add volumes and env vars if output type is cloudwatch or s3 and role is found in the secret
 func addWebIdentityForCloudwatch(collector *v1.Container, forwarderSpec obs.ClusterLogForwarderSpec, secrets observability.Secrets) {
 	if secrets == nil {
 		return
 	}
 	for _, o := range forwarderSpec.Outputs {
-		if o.Type == obs.OutputTypeCloudwatch && o.Cloudwatch.Authentication != nil && o.Cloudwatch.Authentication.Type == obs.CloudwatchAuthTypeIAMRole {
+		var (
+			auth   *obs.CloudwatchAuthentication
+			region string
+		)
+		switch {
+		case o.Type == obs.OutputTypeCloudwatch && o.Cloudwatch != nil:
+			auth, region = o.Cloudwatch.Authentication, o.Cloudwatch.Region
+		case o.Type == obs.OutputTypeS3 && o.S3 != nil:
+			auth, region = o.S3.Authentication, o.S3.Region
+		}
+		if auth != nil && auth.Type == obs.CloudwatchAuthTypeIAMRole {
 
-			if roleARN := cloudwatch.ParseRoleArn(o.Cloudwatch.Authentication, secrets); roleARN != "" {
+			if roleARN := cloudwatch.ParseRoleArn(auth, secrets); roleARN != "" {
 				tokenPath := common.ServiceAccountBasePath(constants.TokenKey)
-				if o.Cloudwatch.Authentication.IAMRole.Token.From == obs.BearerTokenFromSecret {
-					secret := o.Cloudwatch.Authentication.IAMRole.Token.Secret
+				if auth.IAMRole.Token.From == obs.BearerTokenFromSecret {
+					secret := auth.IAMRole.Token.Secret
 					tokenPath = common.SecretPath(secret.Name, secret.Key)
 				}
 
-				AddWebIdentityTokenEnvVars(collector, o.Cloudwatch.Region, roleARN, tokenPath)
+				AddWebIdentityTokenEnvVars(collector, region, roleARN, tokenPath)
 			}
 		}
 	}
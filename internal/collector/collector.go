@@ -162,6 +162,8 @@ func (f *Factory) NewPodSpec(trustedCABundle *v1.ConfigMap, spec obs.ClusterLogF
 
 	f.Visit(collector, podSpec, f.ResourceNames, namespace, f.LogLevel)
 	addWebIdentityForCloudwatch(collector, spec, f.Secrets)
+	addDiskBufferDataDir(podSpec, spec)
+	addConfigWatch(collector, spec)
 
 	podSpec.Containers = []v1.Container{
 		*collector,
@@ -169,6 +171,29 @@ func (f *Factory) NewPodSpec(trustedCABundle *v1.ConfigMap, spec obs.ClusterLogF
 	return podSpec
 }
 
+// addDiskBufferDataDir ensures the host path data directory, where the disk buffers of the outputs are stored, exists on
+// the node.  Host path volumes can not be size limited so the disk buffers are validated against the forwarder disk buffer
+// budget of the collector
+func addDiskBufferDataDir(podSpec *v1.PodSpec, spec obs.ClusterLogForwarderSpec) {
+	if internalobs.Outputs(spec.Outputs).DiskBufferSize() == 0 {
+		return
+	}
+	for i, volume := range podSpec.Volumes {
+		if volume.Name == common.DataDir && volume.HostPath != nil {
+			podSpec.Volumes[i].HostPath.Type = utils.GetPtr(v1.HostPathDirectoryOrCreate)
+		}
+	}
+}
+
+// addConfigWatch enables the collector to reload its config when the forwarder has failover groups.  The config that
+// switches the active output of a group is applied by reloading the config instead of restarting the collector
+func addConfigWatch(collector *v1.Container, spec obs.ClusterLogForwarderSpec) {
+	if len(internalobs.Pipelines(spec.Pipelines).FailoverOutputNames()) == 0 {
+		return
+	}
+	collector.Env = append(collector.Env, v1.EnvVar{Name: "VECTOR_WATCH_CONFIG", Value: "true"})
+}
+
 // NewCollectorContainer is a constructor for creating the collector container spec.  Note the secretNames are assumed
 // to be a unique list
 func (f *Factory) NewCollectorContainer(inputs internalobs.Inputs, outputs internalobs.Outputs, secretVolumes, configmapVolumes []string, clusterID string) *v1.Container {
@@ -703,6 +703,34 @@ var _ = Describe("Factory#NewPodSpec Add Cloudwatch STS Resources", func() {
 			}))
 		})
 
+		It("should add the AWS web identity env vars in the container for an s3 output", func() {
+			s3Outputs := []obs.OutputSpec{
+				{
+					Type: obs.OutputTypeS3,
+					Name: "cw",
+					S3: &obs.S3{
+						Region:         "us-west-42",
+						Bucket:         "my-bucket",
+						Authentication: outputs[0].Cloudwatch.Authentication,
+					},
+				},
+			}
+			podSpec := *factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
+				Outputs:   s3Outputs,
+				Pipelines: pipelines,
+			}, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+			collector := podSpec.Containers[0]
+
+			Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+				Name:  constants.AWSRegionEnvVarKey,
+				Value: s3Outputs[0].S3.Region,
+			}))
+			Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+				Name:  constants.AWSRoleArnEnvVarKey,
+				Value: roleArn,
+			}))
+		})
+
 		It("should mount the secret for the bearer token when spec'd", func() {
 			outputs[0].Cloudwatch.Authentication.IAMRole.Token = bearerToken
 			podSpec := *factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
@@ -727,3 +755,93 @@ var _ = Describe("Factory#NewPodSpec Add Cloudwatch STS Resources", func() {
 		})
 	})
 })
+
+var _ = Describe("Factory#NewPodSpec disk buffers", func() {
+	var (
+		factory   *Factory
+		newOutput = func(buffer *obs.OutputBufferSpec) obs.OutputSpec {
+			return obs.OutputSpec{
+				Name: "http",
+				Type: obs.OutputTypeHTTP,
+				HTTP: &obs.HTTP{
+					Tuning: &obs.HTTPTuningSpec{
+						BaseOutputTuningSpec: obs.BaseOutputTuningSpec{
+							Buffer: buffer,
+						},
+					},
+				},
+			}
+		}
+		dataDir = func(podSpec *v1.PodSpec) *v1.Volume {
+			for _, volume := range podSpec.Volumes {
+				if volume.Name == common.DataDir {
+					return &volume
+				}
+			}
+			return nil
+		}
+	)
+	BeforeEach(func() {
+		factory = &Factory{
+			ImageName:     constants.VectorName,
+			Visit:         vector.CollectorVisitor,
+			ResourceNames: coreFactory.ResourceNames(*obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, constants.SingletonName, runtime.Initialize)),
+			isDaemonset:   true,
+		}
+	})
+
+	It("should create the host path data directory when an output has a disk buffer", func() {
+		podSpec := factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
+			Outputs: []obs.OutputSpec{newOutput(&obs.OutputBufferSpec{
+				Type:    obs.BufferTypeDisk,
+				MaxSize: utils.GetPtr(resource.MustParse("1Gi")),
+			})},
+		}, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+		volume := dataDir(podSpec)
+		Expect(volume).ToNot(BeNil())
+		Expect(volume.HostPath.Path).To(Equal(vector.GetDataPath(constants.OpenshiftNS, constants.SingletonName)))
+		Expect(volume.HostPath.Type).To(Equal(utils.GetPtr(v1.HostPathDirectoryOrCreate)))
+	})
+
+	It("should not change the host path data directory when outputs only have memory buffers", func() {
+		podSpec := factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
+			Outputs: []obs.OutputSpec{newOutput(&obs.OutputBufferSpec{Type: obs.BufferTypeMemory})},
+		}, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+		volume := dataDir(podSpec)
+		Expect(volume).ToNot(BeNil())
+		Expect(volume.HostPath.Type).To(BeNil())
+	})
+})
+
+var _ = Describe("Factory#NewPodSpec failover", func() {
+	var (
+		factory *Factory
+		spec    obs.ClusterLogForwarderSpec
+	)
+	BeforeEach(func() {
+		factory = &Factory{
+			ImageName:     constants.VectorName,
+			Visit:         vector.CollectorVisitor,
+			ResourceNames: coreFactory.ResourceNames(*obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, constants.SingletonName, runtime.Initialize)),
+			isDaemonset:   true,
+		}
+		spec = obs.ClusterLogForwarderSpec{
+			Pipelines: []obs.PipelineSpec{
+				{Name: "mypipeline", InputRefs: []string{"application"}, OutputRefs: []string{"es"}},
+			},
+		}
+	})
+
+	It("should reload the config when the forwarder has failover groups", func() {
+		spec.Pipelines[0].Failover = &obs.FailoverSpec{OutputRefs: []string{"loki", "s3"}}
+		podSpec := factory.NewPodSpec(nil, spec, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+		Expect(podSpec.Containers[0].Env).To(IncludeEnvVar(v1.EnvVar{Name: "VECTOR_WATCH_CONFIG", Value: "true"}))
+	})
+
+	It("should not reload the config without failover groups", func() {
+		podSpec := factory.NewPodSpec(nil, spec, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+		for _, env := range podSpec.Containers[0].Env {
+			Expect(env.Name).ToNot(Equal("VECTOR_WATCH_CONFIG"))
+		}
+	})
+})
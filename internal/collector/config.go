@@ -4,6 +4,8 @@ import (
 	"fmt"
 	log "github.com/ViaQ/logerr/v2/log/static"
 	"github.com/openshift/cluster-logging-operator/internal/collector/vector"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/enrich"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/gcl"
 	"github.com/openshift/cluster-logging-operator/internal/reconcile"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
@@ -15,13 +17,30 @@ import (
 // ReconcileCollectorConfig reconciles a collector config specifically for the collector defined by the factory
 func (f *Factory) ReconcileCollectorConfig(k8sClient client.Client, reader client.Reader, namespace, collectorConfig string, owner metav1.OwnerReference) error {
 	log.V(3).Info("Updating ConfigMap and Secrets")
+	data := map[string]string{
+		vector.ConfigFile:    collectorConfig,
+		vector.RunVectorFile: fmt.Sprintf(vector.RunVectorScript, vector.GetDataPath(namespace, f.ResourceNames.ForwarderName)),
+	}
+	// The collector only reads CSV lookup tables so JSON tables are converted and added to its configuration
+	tables, err := enrich.ConvertedTables(f.ForwarderSpec.Filters, f.ConfigMaps)
+	if err != nil {
+		return err
+	}
+	for file, table := range tables {
+		data[file] = table
+	}
+	// Credential configuration files referencing the token of a workload identity are added for Google Cloud Logging
+	credentials, err := gcl.WorkloadIdentityCredentials(f.ForwarderSpec.Outputs)
+	if err != nil {
+		return err
+	}
+	for file, content := range credentials {
+		data[file] = content
+	}
 	configMap := runtime.NewConfigMap(
 		namespace,
 		f.ResourceNames.ConfigMap,
-		map[string]string{
-			vector.ConfigFile:    collectorConfig,
-			vector.RunVectorFile: fmt.Sprintf(vector.RunVectorScript, vector.GetDataPath(namespace, f.ResourceNames.ForwarderName)),
-		},
+		data,
 		f.CommonLabelInitializer)
 
 	utils.AddOwnerRefToObject(configMap, owner)
@@ -2,6 +2,7 @@ package collector
 
 import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
 	"github.com/openshift/cluster-logging-operator/internal/network"
@@ -21,11 +22,10 @@ func (f *Factory) ReconcileInputServices(k8sClient kubernetes.Client, k8sReader
 	}
 
 	for _, input := range f.ForwarderSpec.Inputs {
-		var listenPort int32
 		serviceName := f.ResourceNames.GenerateInputServiceName(input.Name)
 		if input.Receiver != nil {
-			listenPort = input.Receiver.Port
-			if err := network.ReconcileInputService(k8sClient, namespace, serviceName, f.ResourceNames.CommonName, serviceName, listenPort, listenPort, input.Receiver.Type, owner, visitors); err != nil {
+			ports := internalobs.ReceiverPorts(*input.Receiver)
+			if err := network.ReconcileInputService(k8sClient, namespace, serviceName, f.ResourceNames.CommonName, serviceName, ports, input.Receiver.Type, owner, visitors); err != nil {
 				return err
 			}
 		}
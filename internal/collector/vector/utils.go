@@ -12,7 +12,7 @@ const (
 	RunVectorFile    = "run-vector.sh"
 	DefaultDataPath  = "/var/lib/vector"
 	ConfigFile       = "vector.toml"
-	vectorConfigPath = "/etc/vector"
+	vectorConfigPath = constants.CollectorConfigDir
 	entrypointValue  = "/usr/bin/run-vector.sh"
 )
 
@@ -50,11 +50,13 @@ const (
 	VectorName                 = "vector"
 	KibanaName                 = "kibana"
 	LogfilesmetricexporterName = "logfilesmetricexporter"
+	EventWatcherName           = "event-watcher"
 	PodSecurityLabelEnforce    = "pod-security.kubernetes.io/enforce"
 	PodSecurityLabelValue      = "privileged"
 	// Disable gosec linter, complains "possible hard-coded secret"
 	CollectorSecretsDir         = "/var/run/ocp-collector/secrets" //nolint:gosec
 	ConfigMapBaseDir            = "/var/run/ocp-collector/config"
+	CollectorConfigDir          = "/etc/vector"
 	CollectorName               = "collector"
 	CollectorConfigSecretName   = "collector-config"
 	CollectorMetricSecretName   = "collector-metrics"
@@ -63,6 +65,7 @@ const (
 
 	VectorImageEnvVar         = "RELATED_IMAGE_VECTOR"
 	LogfilesmetricImageEnvVar = "RELATED_IMAGE_LOG_FILE_METRIC_EXPORTER"
+	EventWatcherImageEnvVar   = "RELATED_IMAGE_EVENT_WATCHER"
 
 	ContainerLogDir = "/var/log/containers"
 	PodLogDir       = "/var/log/pods"
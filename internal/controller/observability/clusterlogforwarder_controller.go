@@ -10,20 +10,38 @@ import (
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/collector"
+	"github.com/openshift/cluster-logging-operator/internal/eventwatcher"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	validations "github.com/openshift/cluster-logging-operator/internal/validations/observability"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/set"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
 const (
 	loggerName = "controller.observability"
+
+	// secretNamesIndex indexes a ClusterLogForwarder by the names of the secrets spec'd by its inputs and outputs
+	secretNamesIndex = "spec.secretNames"
+
+	// configMapNamesIndex indexes a ClusterLogForwarder by the names of the configmaps spec'd by its inputs and outputs
+	configMapNamesIndex = "spec.configMapNames"
+
+	// deliveryInterval is the minimum time between evaluations of the delivery of the outputs of a forwarder so the
+	// timed requeues drive the evaluations instead of other events
+	deliveryInterval = time.Minute
 )
 
 var (
@@ -34,6 +52,11 @@ var (
 		RequeueAfter: time.Minute * 5,
 	}
 
+	// failoverRequeue to reevaluate the availability of the outputs of failover groups
+	failoverRequeue = ctrl.Result{
+		RequeueAfter: time.Minute,
+	}
+
 	defaultRequeue = ctrl.Result{}
 )
 
@@ -53,6 +76,8 @@ func (r *ClusterLogForwarderReconciler) Reconcile(ctx context.Context, req ctrl.
 			return defaultRequeue, err
 		}
 		// Stop reconciliation because resource is not present anymore
+		deliverySnapshots.Delete(req.NamespacedName.String())
+		outputProber.Forget(req.NamespacedName)
 		return defaultRequeue, nil
 	}
 
@@ -61,11 +86,12 @@ func (r *ClusterLogForwarderReconciler) Reconcile(ctx context.Context, req ctrl.
 		return defaultRequeue, nil
 	}
 
+	status := r.Forwarder.Status.DeepCopy()
 	removeStaleStatuses(r.Forwarder)
 
 	readyCond := internalobs.NewCondition(obsv1.ConditionTypeReady, obsv1.ConditionUnknown, obsv1.ReasonUnknownState, "")
 	defer func() {
-		updateStatus(r.Client, r.Forwarder, readyCond)
+		updateStatus(r.Client, r.Forwarder, *status, readyCond)
 	}()
 
 	if r.Forwarder.Spec.ManagementState == obsv1.ManagementStateUnmanaged {
@@ -87,6 +113,9 @@ func (r *ClusterLogForwarderReconciler) Reconcile(ctx context.Context, req ctrl.
 			if deleteErr := collector.Remove(r.Client, r.Forwarder.Namespace, r.Forwarder.Name); deleteErr != nil {
 				log.V(0).Error(deleteErr, "Unable to remove collector deployment")
 			}
+			if deleteErr := eventwatcher.Remove(r.Client, r.Forwarder.Namespace, factory.ResourceNames(*r.Forwarder).EventWatcher); deleteErr != nil {
+				log.V(0).Error(deleteErr, "Unable to remove event watcher deployment")
+			}
 		}
 		return defaultRequeue, err
 	}
@@ -97,6 +126,10 @@ func (r *ClusterLogForwarderReconciler) Reconcile(ctx context.Context, req ctrl.
 		return defaultRequeue, err
 	}
 
+	// the delivery of the deployed outputs determines the availability of the outputs of failover groups
+	EvaluateDelivery(r.ForwarderContext, ScrapeCollector, deliveryInterval)
+	EvaluateFailover(r.ForwarderContext, outputProber)
+
 	reconcileErr := ReconcileCollector(r.ForwarderContext, collector.DefaultPollInterval, collector.DefaultTimeOut)
 	if reconcileErr != nil {
 		log.V(2).Error(reconcileErr, "reconcile error")
@@ -107,6 +140,9 @@ func (r *ClusterLogForwarderReconciler) Reconcile(ctx context.Context, req ctrl.
 	readyCond.Reason = obsv1.ReasonReconciliationComplete
 	readyCond.Status = obsv1.ConditionTrue
 
+	if len(internalobs.Pipelines(r.Forwarder.Spec.Pipelines).FailoverOutputNames()) > 0 {
+		return failoverRequeue, nil
+	}
 	return periodicRequeue, nil
 }
 
@@ -120,9 +156,10 @@ func RemoveStaleWorkload(k8Client client.Client, forwarder *obsv1.ClusterLogForw
 	return remove(k8Client, forwarder.Namespace, forwarder.Name)
 }
 
-func MapSecrets(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs) (secretMap map[string]*corev1.Secret, err error) {
+func MapSecrets(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs, filters internalobs.Filters) (secretMap map[string]*corev1.Secret, err error) {
 	names := set.New(inputs.SecretNames()...)
 	names.Insert(outputs.SecretNames()...)
+	names.Insert(filters.SecretNames()...)
 	log.WithName(loggerName).V(4).Info("MapSecrets", "names", names.SortedList())
 	secretMap = map[string]*corev1.Secret{}
 	var secrets []*corev1.Secret
@@ -136,9 +173,10 @@ func MapSecrets(k8Client client.Client, namespace string, inputs internalobs.Inp
 	return secretMap, nil
 }
 
-func MapConfigMaps(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs) (configMaps map[string]*corev1.ConfigMap, err error) {
+func MapConfigMaps(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs, filters internalobs.Filters) (configMaps map[string]*corev1.ConfigMap, err error) {
 	names := set.New(inputs.ConfigmapNames()...)
 	names.Insert(outputs.ConfigmapNames()...)
+	names.Insert(filters.ConfigmapNames()...)
 	log.WithName(loggerName).V(4).Info("MapConfigMaps", "names", names.SortedList())
 	configMaps = map[string]*corev1.ConfigMap{}
 	var configs []*corev1.ConfigMap
@@ -160,7 +198,7 @@ func (r *ClusterLogForwarderReconciler) Initialize() (err error) {
 	migrated := initialize.ClusterLogForwarder(*r.Forwarder, r.AdditionalContext)
 	r.Forwarder = &migrated
 
-	if r.Secrets, err = MapSecrets(r.Client, r.Forwarder.Namespace, r.Forwarder.Spec.Inputs, r.Forwarder.Spec.Outputs); err != nil {
+	if r.Secrets, err = MapSecrets(r.Client, r.Forwarder.Namespace, r.Forwarder.Spec.Inputs, r.Forwarder.Spec.Outputs, r.Forwarder.Spec.Filters); err != nil {
 		return err
 	}
 
@@ -170,7 +208,7 @@ func (r *ClusterLogForwarderReconciler) Initialize() (err error) {
 		}
 	}
 
-	if r.ConfigMaps, err = MapConfigMaps(r.Client, r.Forwarder.Namespace, r.Forwarder.Spec.Inputs, r.Forwarder.Spec.Outputs); err != nil {
+	if r.ConfigMaps, err = MapConfigMaps(r.Client, r.Forwarder.Namespace, r.Forwarder.Spec.Inputs, r.Forwarder.Spec.Outputs, r.Forwarder.Spec.Filters); err != nil {
 		return err
 	}
 	return nil
@@ -178,11 +216,70 @@ func (r *ClusterLogForwarderReconciler) Initialize() (err error) {
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClusterLogForwarderReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	ctx := context.TODO()
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &obsv1.ClusterLogForwarder{}, secretNamesIndex, IndexSecretNames); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &obsv1.ClusterLogForwarder{}, configMapNamesIndex, IndexConfigMapNames); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&obsv1.ClusterLogForwarder{}).
+		// status updates do not trigger reconciliations
+		For(&obsv1.ClusterLogForwarder{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}, predicate.LabelChangedPredicate{}))).
+		Owns(&appsv1.DaemonSet{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&appsv1.Deployment{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&corev1.Service{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(MapToForwarders(r.Client, secretNamesIndex))).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(MapToForwarders(r.Client, configMapNamesIndex))).
 		Complete(r)
 }
 
+// IndexSecretNames returns the names of the secrets referenced by the inputs, outputs and filters of a ClusterLogForwarder
+func IndexSecretNames(obj client.Object) []string {
+	forwarder, ok := obj.(*obsv1.ClusterLogForwarder)
+	if !ok {
+		return nil
+	}
+	names := set.New(internalobs.Inputs(forwarder.Spec.Inputs).SecretNames()...)
+	names.Insert(internalobs.Outputs(forwarder.Spec.Outputs).SecretNames()...)
+	names.Insert(internalobs.Filters(forwarder.Spec.Filters).SecretNames()...)
+	return names.SortedList()
+}
+
+// IndexConfigMapNames returns the names of the configmaps referenced by the inputs, outputs and filters of a ClusterLogForwarder
+func IndexConfigMapNames(obj client.Object) []string {
+	forwarder, ok := obj.(*obsv1.ClusterLogForwarder)
+	if !ok {
+		return nil
+	}
+	names := set.New(internalobs.Inputs(forwarder.Spec.Inputs).ConfigmapNames()...)
+	names.Insert(internalobs.Outputs(forwarder.Spec.Outputs).ConfigmapNames()...)
+	names.Insert(internalobs.Filters(forwarder.Spec.Filters).ConfigmapNames()...)
+	return names.SortedList()
+}
+
+// MapToForwarders returns a function that maps an object to reconcile requests for each ClusterLogForwarder
+// in the same namespace that references the object by name using the given index
+func MapToForwarders(k8sClient client.Client, index string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []ctrl.Request {
+		forwarders := &obsv1.ClusterLogForwarderList{}
+		if err := k8sClient.List(ctx, forwarders, client.InNamespace(obj.GetNamespace()), client.MatchingFields{index: obj.GetName()}); err != nil {
+			log.WithName(loggerName).V(0).Error(err, "unable to list forwarders referencing object", "index", index, "namespace", obj.GetNamespace(), "name", obj.GetName())
+			return nil
+		}
+		var requests []ctrl.Request
+		for _, forwarder := range forwarders.Items {
+			requests = append(requests, ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: forwarder.Namespace,
+					Name:      forwarder.Name,
+				},
+			})
+		}
+		return requests
+	}
+}
+
 func validateForwarder(forwarderContext internalcontext.ForwarderContext) (valid bool) {
 	validations.ValidateClusterLogForwarder(forwarderContext)
 
@@ -196,8 +293,12 @@ func validateForwarder(forwarderContext internalcontext.ForwarderContext) (valid
 	return valid
 }
 
-func updateStatus(k8Client client.Client, instance *obsv1.ClusterLogForwarder, ready metav1.Condition) {
+// updateStatus sets the ready condition and updates the status of the forwarder when it differs from the original status
+func updateStatus(k8Client client.Client, instance *obsv1.ClusterLogForwarder, original obsv1.ClusterLogForwarderStatus, ready metav1.Condition) {
 	internalobs.SetCondition(&instance.Status.Conditions, ready)
+	if equality.Semantic.DeepEqual(original, instance.Status) {
+		return
+	}
 	if err := k8Client.Status().Update(context.TODO(), instance); err != nil {
 		log.Error(err, "clusterlogforwarder-controller error updating status", "status", instance.Status)
 	}
@@ -0,0 +1,182 @@
+package observability_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("ClusterLogForwarder watches", func() {
+
+	const (
+		namespace = "mynamespace"
+	)
+
+	var (
+		forwarder = obsruntime.NewClusterLogForwarder(namespace, "myforwarder", runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+			clf.Spec.Inputs = []obs.InputSpec{
+				{
+					Name: "myreceiver",
+					Type: obs.InputTypeReceiver,
+					Receiver: &obs.ReceiverSpec{
+						Type: obs.ReceiverTypeHTTP,
+						TLS: &obs.InputTLSSpec{
+							CA:  &obs.ValueReference{Key: "ca.crt", ConfigMapName: "receiver-ca"},
+							Key: &obs.SecretReference{Key: "tls.key", SecretName: "receiver-tls"},
+						},
+					},
+				},
+			}
+			clf.Spec.Outputs = []obs.OutputSpec{
+				{
+					Name: "es",
+					Type: obs.OutputTypeElasticsearch,
+					Elasticsearch: &obs.Elasticsearch{
+						Authentication: &obs.HTTPAuthentication{
+							Username: &obs.SecretReference{Key: "username", SecretName: "es-auth"},
+							Password: &obs.SecretReference{Key: "password", SecretName: "es-auth"},
+						},
+					},
+					TLS: &obs.OutputTLSSpec{
+						TLSSpec: obs.TLSSpec{
+							CA: &obs.ValueReference{Key: "ca.crt", ConfigMapName: "es-ca"},
+						},
+					},
+				},
+			}
+			clf.Spec.Filters = []obs.FilterSpec{
+				{
+					Name: "redact",
+					Type: obs.FilterTypeRedact,
+					RedactFilterSpec: &obs.RedactFilterSpec{
+						Detectors: []obs.RedactDetector{obs.RedactDetectorEmail},
+						Replacement: &obs.RedactReplacement{
+							Type: obs.RedactReplacementHash,
+							Salt: &obs.SecretReference{Key: "salt", SecretName: "redact-salt"},
+						},
+					},
+				},
+				{
+					Name: "enrich",
+					Type: obs.FilterTypeEnrich,
+					EnrichFilterSpec: &obs.EnrichFilterSpec{
+						Table:     obs.ConfigMapReference{Key: "teams.csv", ConfigMapName: "teams"},
+						Key:       `{.kubernetes.namespace_name||"none"}`,
+						KeyColumn: "namespace",
+						Columns:   []string{"team"},
+					},
+				},
+			}
+		})
+		other = obsruntime.NewClusterLogForwarder(namespace, "other", runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+			clf.Spec.Outputs = []obs.OutputSpec{
+				{
+					Name: "splunk",
+					Type: obs.OutputTypeSplunk,
+					Splunk: &obs.Splunk{
+						Authentication: &obs.SplunkAuthentication{
+							Token: &obs.SecretReference{Key: "hecToken", SecretName: "es-auth"},
+						},
+					},
+				},
+			}
+		})
+	)
+
+	Context("#IndexSecretNames", func() {
+		It("should index the secrets referenced by inputs, outputs and filters", func() {
+			Expect(observability.IndexSecretNames(forwarder)).To(Equal([]string{"es-auth", "receiver-tls", "redact-salt"}))
+		})
+		It("should not index objects that are not forwarders", func() {
+			Expect(observability.IndexSecretNames(&corev1.Secret{})).To(BeEmpty())
+		})
+	})
+
+	Context("#IndexConfigMapNames", func() {
+		It("should index the configmaps referenced by inputs, outputs and filters", func() {
+			Expect(observability.IndexConfigMapNames(forwarder)).To(Equal([]string{"es-ca", "receiver-ca", "teams"}))
+		})
+	})
+
+	Context("#MapToForwarders", func() {
+		var (
+			mapToForwarders = func(index string, obj client.Object) []ctrl.Request {
+				k8sClient := fake.NewClientBuilder().
+					WithScheme(scheme.Scheme).
+					WithObjects(forwarder, other).
+					WithIndex(&obs.ClusterLogForwarder{}, "spec.secretNames", observability.IndexSecretNames).
+					WithIndex(&obs.ClusterLogForwarder{}, "spec.configMapNames", observability.IndexConfigMapNames).
+					Build()
+				return observability.MapToForwarders(k8sClient, index)(context.TODO(), obj)
+			}
+		)
+		BeforeEach(func() {
+			Expect(obs.AddToScheme(scheme.Scheme)).To(Succeed())
+		})
+
+		It("should enqueue every forwarder that references a secret", func() {
+			Expect(mapToForwarders("spec.secretNames", runtime.NewSecret(namespace, "es-auth", nil))).To(ConsistOf(
+				ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "myforwarder"}},
+				ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "other"}},
+			))
+		})
+		It("should enqueue only the forwarders that reference a configmap", func() {
+			Expect(mapToForwarders("spec.configMapNames", runtime.NewConfigMap(namespace, "es-ca", nil))).To(ConsistOf(
+				ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "myforwarder"}},
+			))
+		})
+		It("should not enqueue forwarders for unreferenced objects", func() {
+			Expect(mapToForwarders("spec.configMapNames", runtime.NewConfigMap(namespace, "unknown", nil))).To(BeEmpty())
+		})
+		It("should not enqueue forwarders from other namespaces", func() {
+			Expect(mapToForwarders("spec.configMapNames", runtime.NewConfigMap("othernamespace", "es-ca", nil))).To(BeEmpty())
+		})
+	})
+
+	Context("#Reconcile", func() {
+		BeforeEach(func() {
+			Expect(obs.AddToScheme(scheme.Scheme)).To(Succeed())
+		})
+
+		It("should only update the status of a forwarder when it changes", func() {
+			unmanaged := obsruntime.NewClusterLogForwarder(namespace, "unmanaged", runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+				clf.Spec.ManagementState = obs.ManagementStateUnmanaged
+			})
+			updates := 0
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(unmanaged).
+				WithStatusSubresource(unmanaged).
+				WithInterceptorFuncs(interceptor.Funcs{
+					SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						updates++
+						return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+					},
+				}).
+				Build()
+			reconciler := &observability.ClusterLogForwarderReconciler{}
+			reconciler.Client = k8sClient
+			request := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "unmanaged"}}
+
+			_, err := reconciler.Reconcile(context.TODO(), request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updates).To(Equal(1))
+
+			_, err = reconciler.Reconcile(context.TODO(), request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updates).To(Equal(1), "Exp. no status update when the status is unchanged")
+		})
+	})
+})
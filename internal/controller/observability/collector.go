@@ -8,10 +8,12 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/auth"
 	"github.com/openshift/cluster-logging-operator/internal/collector"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/eventwatcher"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
 	forwardergenerator "github.com/openshift/cluster-logging-operator/internal/generator/forwarder"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	generatorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/gcl"
 	"github.com/openshift/cluster-logging-operator/internal/metrics"
 	"github.com/openshift/cluster-logging-operator/internal/network"
 	"github.com/openshift/cluster-logging-operator/internal/reconcile"
@@ -19,6 +21,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/tls"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"strings"
 	"time"
@@ -35,6 +38,9 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 	resourceNames := factory.ResourceNames(*context.Forwarder)
 
 	options := framework.Options{}
+	if active, found := utils.GetOption(context.AdditionalContext, framework.OptionFailoverOutputs, map[string]string{}); found {
+		options[framework.OptionFailoverOutputs] = active
+	}
 	if internalobs.Outputs(context.Forwarder.Spec.Outputs).NeedServiceAccountToken() {
 		// temporarily create SA token until collector is capable of dynamically reloading a projected serviceaccount token
 		var sa *corev1.ServiceAccount
@@ -71,8 +77,23 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 		return err
 	}
 	log.V(3).Info("Generated collector config", "config", collectorConfig)
+	// the collector reloads its config when the active output of a failover group changes so the hash that restarts
+	// the collector is of the config that forwards to the primary outputs of the groups
+	hashedConfig := collectorConfig
+	if _, found := options[framework.OptionFailoverOutputs]; found {
+		primaryOptions := framework.Options{}
+		for k, v := range options {
+			if k != framework.OptionFailoverOutputs {
+				primaryOptions[k] = v
+			}
+		}
+		if hashedConfig, err = GenerateConfig(context.Client, *context.Forwarder, *resourceNames, context.Secrets, primaryOptions); err != nil {
+			log.V(9).Error(err, "collector.GenerateConfig")
+			return err
+		}
+	}
 	var collectorConfHash string
-	collectorConfHash, err = utils.CalculateMD5Hash(collectorConfig)
+	collectorConfHash, err = utils.CalculateMD5Hash(hashedConfig + enrichmentTablesHash(context) + workloadIdentityCredentials(context))
 	if err != nil {
 		log.Error(err, "unable to calculate MD5 hash")
 		log.V(9).Error(err, "Returning from unable to calculate MD5 hash")
@@ -96,6 +117,11 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 		return err
 	}
 
+	if err := eventwatcher.Reconcile(context.Client, *context.Forwarder, *resourceNames, ownerRef); err != nil {
+		log.Error(err, "eventwatcher.Reconcile")
+		return err
+	}
+
 	if err := factory.ReconcileInputServices(context.Client, context.Reader, context.Forwarder.Namespace, ownerRef, factory.CommonLabelInitializer); err != nil {
 		log.Error(err, "collector.ReconcileInputServices")
 		return err
@@ -131,6 +157,36 @@ func GenerateConfig(k8Client client.Client, spec obs.ClusterLogForwarder, resour
 	return generatedConfig, err
 }
 
+// enrichmentTablesHash returns a hash of the configmaps of the lookup tables of the forwarder. The collector reads lookup
+// tables when it starts so edits to them must roll the collector
+func enrichmentTablesHash(context internalcontext.ForwarderContext) string {
+	tables := internalobs.ConfigMaps{}
+	for _, name := range internalobs.Filters(context.Forwarder.Spec.Filters).ConfigmapNames() {
+		if cm, found := context.ConfigMaps[name]; found {
+			tables[name] = cm
+		}
+	}
+	if len(tables) == 0 {
+		return ""
+	}
+	return tables.Hash64a()
+}
+
+// workloadIdentityCredentials returns the credential configuration files of the Google Cloud Logging outputs. The collector
+// reads them when it starts so edits to the workload identity of an output must roll the collector
+func workloadIdentityCredentials(context internalcontext.ForwarderContext) string {
+	files, err := gcl.WorkloadIdentityCredentials(context.Forwarder.Spec.Outputs)
+	if err != nil {
+		log.V(3).Error(err, "unable to generate the workload identity credentials")
+		return ""
+	}
+	content := ""
+	for _, name := range sets.List(sets.KeySet(files)) {
+		content += files[name]
+	}
+	return content
+}
+
 // EvaluateAnnotationsForEnabledCapabilities populates generator options with capabilities enabled by the ClusterLogForwarder
 func EvaluateAnnotationsForEnabledCapabilities(annotations map[string]string, options framework.Options) {
 	if annotations == nil {
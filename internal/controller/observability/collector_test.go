@@ -5,6 +5,7 @@ import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
@@ -255,6 +256,50 @@ var _ = Describe("Reconciling the Collector", func() {
 			Entry("when deployed as a DaemonSet", forwarder, &appsv1.DaemonSet{}, podTemplateFromDaemonSet),
 			Entry("when deployed as a Deployment", receiverForwarder, &appsv1.Deployment{}, podTemplateSpecFromDeployment),
 		)
+		It("should reload the config instead of restarting the collector when the active output of a failover group changes", func() {
+			httpOutput := func(name string) obs.OutputSpec {
+				return obs.OutputSpec{Name: name, Type: obs.OutputTypeHTTP, HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "http://" + name + ":8080"}}}
+			}
+			clf := obsruntime.NewClusterLogForwarder(namespaceName, clfName, runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+				clf.Spec = obs.ClusterLogForwarderSpec{
+					ServiceAccount: obs.ServiceAccount{Name: saName},
+					Outputs:        []obs.OutputSpec{httpOutput("primary"), httpOutput("secondary")},
+					Pipelines: []obs.PipelineSpec{
+						{
+							Name:      "mypipeline",
+							InputRefs: []string{string(obs.InputTypeApplication)},
+							Failover:  &obs.FailoverSpec{OutputRefs: []string{"primary", "secondary"}},
+						},
+					},
+				}
+			})
+			beforeEach(clf)
+			deployed := func(active string) (string, string) {
+				forwarderContext := apicontext.ForwarderContext{
+					Client:            client,
+					Reader:            client,
+					Forwarder:         clf,
+					ClusterID:         clusterID,
+					Secrets:           map[string]*corev1.Secret{},
+					AdditionalContext: utils.Options{framework.OptionFailoverOutputs: map[string]string{"mypipeline": active}},
+				}
+				Expect(observability.ReconcileCollector(forwarderContext, 1*time.Millisecond, 1*time.Millisecond)).Should(Succeed())
+				ds := &appsv1.DaemonSet{}
+				Expect(client.Get(context.TODO(), types.NamespacedName{Name: clfName, Namespace: namespaceName}, ds)).Should(Succeed())
+				cm := &corev1.ConfigMap{}
+				Expect(client.Get(context.TODO(), types.NamespacedName{Name: resourceNames.ConfigMap, Namespace: namespaceName}, cm)).Should(Succeed())
+				for _, env := range ds.Spec.Template.Spec.Containers[0].Env {
+					if env.Name == "COLLECTOR_CONF_HASH" {
+						return env.Value, cm.Data["vector.toml"]
+					}
+				}
+				return "", cm.Data["vector.toml"]
+			}
+			primaryHash, primaryConfig := deployed("primary")
+			secondaryHash, secondaryConfig := deployed("secondary")
+			Expect(secondaryConfig).ToNot(Equal(primaryConfig), "Exp. the config to forward to the active output")
+			Expect(secondaryHash).To(Equal(primaryHash), "Exp. the collector not to be restarted")
+		})
 		DescribeTable("when the cluster proxy is not present should not error", func(clf *obs.ClusterLogForwarder, obj cli.Object, templateSpec func(obj cli.Object) corev1.PodTemplateSpec) {
 			beforeEach(clf)
 
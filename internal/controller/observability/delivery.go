@@ -0,0 +1,102 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/collector"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/metrics/delivery"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// deliverySnapshots keeps the latest collector metrics of each forwarder to evaluate the delivery health of its outputs
+var deliverySnapshots = delivery.NewStore()
+
+// scrapeDeadline is the maximum time spent scraping the collector pods of a forwarder during a reconciliation
+const scrapeDeadline = 15 * time.Second
+
+// MetricsScraper fetches the metrics of the collector pods of a forwarder
+type MetricsScraper func(internalcontext.ForwarderContext) (delivery.CollectorSnapshot, error)
+
+// EvaluateDelivery compares the collector metrics to the metrics of the previous evaluation and sets the delivery
+// condition of each output.  The collector is only scraped when the previous evaluation is at least as old as the
+// interval so reconciliations between the timed requeues of the forwarder keep the conditions of the last evaluation
+func EvaluateDelivery(context internalcontext.ForwarderContext, scrape MetricsScraper, interval time.Duration) {
+	forwarder := context.Forwarder
+	key := types.NamespacedName{Namespace: forwarder.Namespace, Name: forwarder.Name}.String()
+	if !deliverySnapshots.Due(key, interval) {
+		return
+	}
+	current, err := scrape(context)
+	if err != nil {
+		log.WithName(loggerName).V(3).Error(err, "Unable to scrape the collector metrics", "namespace", forwarder.Namespace, "name", forwarder.Name)
+		return
+	}
+	previous := deliverySnapshots.Swap(key, current)
+	for _, o := range forwarder.Spec.Outputs {
+		conditionType := obs.ConditionTypeDeliveringOutputPrefix + "-" + o.Name
+		id := helpers.MakeOutputID(o.Name)
+		switch {
+		case !current.Reports(id):
+			internalobs.SetCondition(&forwarder.Status.OutputConditions,
+				internalobs.NewCondition(conditionType, obs.ConditionUnknown, obs.ReasonUnknownState, fmt.Sprintf("the collector reports no metrics for output %q", o.Name)))
+		case previous == nil:
+			internalobs.SetCondition(&forwarder.Status.OutputConditions,
+				internalobs.NewCondition(conditionType, obs.ConditionUnknown, obs.ReasonUnknownState, fmt.Sprintf("the delivery to output %q is evaluated on the next reconciliation", o.Name)))
+		default:
+			health := delivery.Evaluate(previous, current, id)
+			log.WithName(loggerName).V(3).Info("Evaluated output delivery", "namespace", forwarder.Namespace, "name", forwarder.Name, "output", o.Name,
+				"degraded", health.Degraded, "sent", health.Sent, "errors", health.Errors, "discarded", health.Discarded, "bufferRatio", health.BufferRatio)
+			if health.Degraded {
+				internalobs.SetCondition(&forwarder.Status.OutputConditions,
+					internalobs.NewConditionFromPrefix(obs.ConditionTypeDeliveringOutputPrefix, o.Name, false, obs.ReasonDegraded, health.Message))
+			} else {
+				internalobs.SetCondition(&forwarder.Status.OutputConditions,
+					internalobs.NewConditionFromPrefix(obs.ConditionTypeDeliveringOutputPrefix, o.Name, true, obs.ReasonDelivering, health.Message))
+			}
+		}
+	}
+}
+
+// ScrapeCollector fetches the metrics of the running collector pods of a forwarder concurrently within the scrape
+// deadline.  Pods that can not be scraped are skipped
+func ScrapeCollector(forwarderContext internalcontext.ForwarderContext) (delivery.CollectorSnapshot, error) {
+	forwarder := forwarderContext.Forwarder
+	resourceNames := factory.ResourceNames(*forwarder)
+	pods := &corev1.PodList{}
+	selector := runtime.Selectors(resourceNames.CommonName, constants.CollectorName, constants.VectorName)
+	if err := forwarderContext.Reader.List(context.TODO(), pods, client.InNamespace(forwarder.Namespace), client.MatchingLabels(selector)); err != nil {
+		return nil, err
+	}
+	var targets []delivery.Target
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" {
+			continue
+		}
+		targets = append(targets, delivery.Target{
+			UID:      string(pod.UID),
+			Endpoint: fmt.Sprintf("https://%s/metrics", net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(collector.MetricsPort)))),
+		})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeDeadline)
+	defer cancel()
+	httpClient := delivery.NewClient(fmt.Sprintf("%s.%s.svc", resourceNames.CommonName, forwarder.Namespace))
+	snapshot, errs := delivery.ScrapeAll(ctx, httpClient, targets)
+	for _, err := range errs {
+		log.WithName(loggerName).V(3).Error(err, "Unable to scrape a collector pod", "namespace", forwarder.Namespace, "name", forwarder.Name)
+	}
+	return snapshot, nil
+}
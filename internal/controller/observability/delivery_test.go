@@ -0,0 +1,69 @@
+package observability_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/metrics/delivery"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+var _ = Describe("#EvaluateDelivery", func() {
+
+	var (
+		context internalcontext.ForwarderContext
+		scrape  = func(snapshot delivery.Snapshot) observability.MetricsScraper {
+			return func(internalcontext.ForwarderContext) (delivery.CollectorSnapshot, error) {
+				return delivery.CollectorSnapshot{"pod1": snapshot}, nil
+			}
+		}
+		metrics = func(sent, errors float64) *delivery.ComponentMetrics {
+			return &delivery.ComponentMetrics{SentEvents: sent, Errors: errors, ErrorTypes: map[string]float64{"request_failed": errors}}
+		}
+	)
+
+	BeforeEach(func() {
+		forwarder := obsruntime.NewClusterLogForwarder("mynamespace", "delivery", runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+			clf.Spec.Outputs = []obs.OutputSpec{
+				{Name: "es-out", Type: obs.OutputTypeElasticsearch},
+				{Name: "loki", Type: obs.OutputTypeLoki},
+			}
+		})
+		context = internalcontext.ForwarderContext{Forwarder: forwarder}
+	})
+
+	It("should evaluate the delivery of each output between reconciliations", func() {
+		observability.EvaluateDelivery(context, scrape(delivery.Snapshot{"output_es_out": metrics(10, 0), "output_loki": metrics(10, 0)}), 0)
+		cond := meta.FindStatusCondition(context.Forwarder.Status.OutputConditions, obs.ConditionTypeDeliveringOutputPrefix+"-es-out")
+		Expect(cond.Status).To(Equal(obs.ConditionUnknown))
+		Expect(cond.Message).To(ContainSubstring("evaluated on the next reconciliation"))
+
+		observability.EvaluateDelivery(context, scrape(delivery.Snapshot{"output_es_out": metrics(20, 0), "output_loki": metrics(10, 3)}), 0)
+		Expect(context.Forwarder.Status.OutputConditions).To(HaveCondition(obs.ConditionTypeDeliveringOutputPrefix+"-es-out", true, obs.ReasonDelivering, "events are delivered without errors"))
+		Expect(context.Forwarder.Status.OutputConditions).To(HaveCondition(obs.ConditionTypeDeliveringOutputPrefix+"-loki", false, obs.ReasonDegraded, `errors are reported \(error types: request_failed\)`))
+	})
+
+	It("should keep the conditions of the last evaluation until the interval elapsed", func() {
+		context.Forwarder.Name = "interval"
+		observability.EvaluateDelivery(context, scrape(delivery.Snapshot{"output_es_out": metrics(10, 0), "output_loki": metrics(10, 0)}), time.Hour)
+		observability.EvaluateDelivery(context, scrape(delivery.Snapshot{"output_es_out": metrics(20, 0), "output_loki": metrics(10, 3)}), time.Hour)
+		cond := meta.FindStatusCondition(context.Forwarder.Status.OutputConditions, obs.ConditionTypeDeliveringOutputPrefix+"-loki")
+		Expect(cond.Status).To(Equal(obs.ConditionUnknown))
+		Expect(cond.Message).To(ContainSubstring("evaluated on the next reconciliation"))
+	})
+
+	It("should report outputs without metrics", func() {
+		context.Forwarder.Name = "nometrics"
+		observability.EvaluateDelivery(context, scrape(delivery.Snapshot{}), 0)
+		cond := meta.FindStatusCondition(context.Forwarder.Status.OutputConditions, obs.ConditionTypeDeliveringOutputPrefix+"-loki")
+		Expect(cond.Status).To(Equal(obs.ConditionUnknown))
+		Expect(cond.Message).To(Equal(`the collector reports no metrics for output "loki"`))
+	})
+})
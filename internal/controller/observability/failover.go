@@ -0,0 +1,199 @@
+package observability
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/url"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/set"
+)
+
+// probeTimeout is the maximum time to wait for a connection to the endpoint of an output
+const probeTimeout = 5 * time.Second
+
+// outputProber probes the endpoints of the inactive outputs of failover groups of all forwarders
+var outputProber = NewOutputProber(ProbeOutput)
+
+// OutputProbe determines if the endpoint of an output is available
+type OutputProbe func(obs.OutputSpec) error
+
+// OutputProber probes the endpoints of outputs in the background so reconciliations do not wait on connections.  The
+// result of a probe is available to the reconciliation following the probe
+type OutputProber struct {
+	probe OutputProbe
+
+	lock    sync.Mutex
+	wg      sync.WaitGroup
+	results map[string]error
+	pending set.Set[string]
+}
+
+// NewOutputProber returns a prober that probes outputs using the probe
+func NewOutputProber(probe OutputProbe) *OutputProber {
+	return &OutputProber{
+		probe:   probe,
+		results: map[string]error{},
+		pending: set.New[string](),
+	}
+}
+
+// Result returns the result of the last probe of an output of a forwarder and whether the output has been probed.
+// It starts a new probe of the output in the background unless one is pending
+func (p *OutputProber) Result(forwarder types.NamespacedName, o obs.OutputSpec) (result error, found bool) {
+	key := proberKey(forwarder, o.Name)
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	result, found = p.results[key]
+	if !p.pending.Has(key) {
+		p.pending.Insert(key)
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			err := p.probe(o)
+			p.lock.Lock()
+			defer p.lock.Unlock()
+			p.pending.Delete(key)
+			p.results[key] = err
+		}()
+	}
+	return result, found
+}
+
+// Wait blocks until the pending probes are complete
+func (p *OutputProber) Wait() {
+	p.wg.Wait()
+}
+
+// Forget removes the probe results of the outputs of a forwarder
+func (p *OutputProber) Forget(forwarder types.NamespacedName) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	prefix := proberKey(forwarder, "")
+	for key := range p.results {
+		if strings.HasPrefix(key, prefix) {
+			delete(p.results, key)
+		}
+	}
+}
+
+func proberKey(forwarder types.NamespacedName, output string) string {
+	return forwarder.String() + "/" + output
+}
+
+// EvaluateFailover evaluates the availability of the outputs of the failover groups of all pipelines, records their
+// availability and the active output of each group in the status of the forwarder, and adds the active outputs to the
+// additional context for generating the collector config.
+//
+// The availability of an output deployed to the collector is its delivery condition evaluated from the collector
+// metrics.  Outputs that are not deployed (e.g. the inactive outputs of a group) are probed by the prober to fail back.
+// A probe only marks an output available that has been unavailable for at least the unavailable duration of its groups
+func EvaluateFailover(context internalcontext.ForwarderContext, prober *OutputProber) {
+	forwarder := context.Forwarder
+	pipelines := internalobs.Pipelines(forwarder.Spec.Pipelines)
+	members := set.New(pipelines.FailoverOutputNames()...)
+	for _, o := range forwarder.Spec.Outputs {
+		conditionType := obs.ConditionTypeAvailableOutputPrefix + "-" + o.Name
+		if !members.Has(o.Name) {
+			meta.RemoveStatusCondition(&forwarder.Status.OutputConditions, conditionType)
+			continue
+		}
+		delivering := meta.FindStatusCondition(forwarder.Status.OutputConditions, obs.ConditionTypeDeliveringOutputPrefix+"-"+o.Name)
+		if delivering != nil && delivering.Status != obs.ConditionUnknown {
+			setAvailability(forwarder, o.Name, delivering.Status == obs.ConditionTrue, delivering.Message)
+			continue
+		}
+		err, found := prober.Result(types.NamespacedName{Namespace: forwarder.Namespace, Name: forwarder.Name}, o)
+		if !found {
+			continue
+		}
+		if err != nil {
+			setAvailability(forwarder, o.Name, false, err.Error())
+			continue
+		}
+		current := meta.FindStatusCondition(forwarder.Status.OutputConditions, conditionType)
+		if current == nil || current.Status != obs.ConditionFalse ||
+			time.Since(current.LastTransitionTime.Time) >= unavailableDuration(pipelines, o.Name) {
+			setAvailability(forwarder, o.Name, true, "the endpoint accepts connections")
+		}
+	}
+
+	active := map[string]string{}
+	for _, p := range pipelines {
+		if p.Failover == nil || len(p.Failover.OutputRefs) == 0 {
+			meta.RemoveStatusCondition(&forwarder.Status.PipelineConditions, obs.ConditionTypeFailoverPrefix+"-"+p.Name)
+			continue
+		}
+		ref := internalobs.ActiveFailoverOutput(p, forwarder.Status.OutputConditions)
+		active[p.Name] = ref
+		failedOver := ref != p.Failover.OutputRefs[0]
+		reason := obs.ReasonPrimaryOutputActive
+		if failedOver {
+			reason = obs.ReasonFailoverOutputActive
+		}
+		internalobs.SetCondition(&forwarder.Status.PipelineConditions,
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeFailoverPrefix, p.Name, failedOver, reason, fmt.Sprintf("output %q is active", ref)))
+	}
+	if len(active) > 0 && context.AdditionalContext != nil {
+		context.AdditionalContext[framework.OptionFailoverOutputs] = active
+	}
+}
+
+func setAvailability(forwarder *obs.ClusterLogForwarder, name string, available bool, message string) {
+	if available {
+		internalobs.SetCondition(&forwarder.Status.OutputConditions,
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeAvailableOutputPrefix, name, true, obs.ReasonOutputAvailable, fmt.Sprintf("output %q is available: %s", name, message)))
+		return
+	}
+	internalobs.SetCondition(&forwarder.Status.OutputConditions,
+		internalobs.NewConditionFromPrefix(obs.ConditionTypeAvailableOutputPrefix, name, false, obs.ReasonOutputUnavailable, fmt.Sprintf("output %q is unavailable: %s", name, message)))
+}
+
+// unavailableDuration is the longest unavailable duration of the failover groups of an output
+func unavailableDuration(pipelines internalobs.Pipelines, name string) (duration time.Duration) {
+	for _, p := range pipelines {
+		if p.Failover != nil && set.New(p.Failover.OutputRefs...).Has(name) {
+			if d := internalobs.FailoverUnavailableDuration(p); d > duration {
+				duration = d
+			}
+		}
+	}
+	return duration
+}
+
+// ProbeOutput connects to the endpoint of an output. Outputs without an endpoint URL or with an endpoint
+// that can not be probed (e.g. UDP) are considered available
+func ProbeOutput(o obs.OutputSpec) error {
+	u, err := url.Parse(internalobs.URL(o))
+	if err != nil || u.Hostname() == "" {
+		return nil
+	}
+	port := u.Port()
+	if port == "" {
+		switch url.PlainScheme(u.Scheme) {
+		case "http":
+			port = "80"
+			if url.IsTLSScheme(u.Scheme) {
+				port = "443"
+			}
+		default:
+			return nil
+		}
+	}
+	if strings.HasPrefix(url.PlainScheme(u.Scheme), "udp") {
+		return nil
+	}
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(u.Hostname(), port), probeTimeout)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
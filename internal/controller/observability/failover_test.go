@@ -0,0 +1,141 @@
+package observability_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("#EvaluateFailover", func() {
+
+	var (
+		context internalcontext.ForwarderContext
+		probe   = func(unavailable ...string) observability.OutputProbe {
+			return func(o obs.OutputSpec) error {
+				for _, name := range unavailable {
+					if o.Name == name {
+						return errors.New("connection refused")
+					}
+				}
+				return nil
+			}
+		}
+		// evaluate evaluates the failover twice so the results of the background probes are available
+		evaluate = func(prober *observability.OutputProber) {
+			observability.EvaluateFailover(context, prober)
+			prober.Wait()
+			observability.EvaluateFailover(context, prober)
+		}
+		delivering = func(name string, status bool) {
+			reason := obs.ReasonDelivering
+			if !status {
+				reason = obs.ReasonDegraded
+			}
+			internalobs.SetCondition(&context.Forwarder.Status.OutputConditions,
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeDeliveringOutputPrefix, name, status, reason, "3 errors"))
+		}
+	)
+
+	BeforeEach(func() {
+		forwarder := obsruntime.NewClusterLogForwarder("mynamespace", "myforwarder", runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+			clf.Spec.Outputs = []obs.OutputSpec{
+				{Name: "loki", Type: obs.OutputTypeLoki},
+				{Name: "s3", Type: obs.OutputTypeS3},
+				{Name: "es", Type: obs.OutputTypeElasticsearch},
+			}
+			clf.Spec.Pipelines = []obs.PipelineSpec{
+				{
+					Name:       "failover",
+					InputRefs:  []string{"application"},
+					OutputRefs: []string{"es"},
+					Failover: &obs.FailoverSpec{
+						OutputRefs:          []string{"loki", "s3"},
+						UnavailableDuration: &metav1.Duration{},
+					},
+				},
+				{
+					Name:       "plain",
+					InputRefs:  []string{"application"},
+					OutputRefs: []string{"es"},
+				},
+			}
+		})
+		context = internalcontext.ForwarderContext{
+			Forwarder:         forwarder,
+			AdditionalContext: utils.Options{},
+		}
+	})
+
+	It("should activate the primary output when it is available", func() {
+		evaluate(observability.NewOutputProber(probe()))
+		Expect(context.Forwarder.Status.OutputConditions).To(HaveCondition(obs.ConditionTypeAvailableOutputPrefix+"-loki", true, obs.ReasonOutputAvailable, ""))
+		Expect(context.Forwarder.Status.PipelineConditions).To(HaveCondition(obs.ConditionTypeFailoverPrefix+"-failover", false, obs.ReasonPrimaryOutputActive, `output "loki" is active`))
+		Expect(context.AdditionalContext[framework.OptionFailoverOutputs]).To(Equal(map[string]string{"failover": "loki"}))
+	})
+
+	It("should not wait for the probes of outputs", func() {
+		observability.EvaluateFailover(context, observability.NewOutputProber(func(obs.OutputSpec) error {
+			time.Sleep(time.Minute)
+			return nil
+		}))
+		Expect(meta.FindStatusCondition(context.Forwarder.Status.OutputConditions, obs.ConditionTypeAvailableOutputPrefix+"-loki")).To(BeNil())
+		Expect(context.AdditionalContext[framework.OptionFailoverOutputs]).To(Equal(map[string]string{"failover": "loki"}))
+	})
+
+	It("should fail over when the collector reports the primary output is degraded", func() {
+		delivering("loki", false)
+		evaluate(observability.NewOutputProber(probe()))
+		Expect(context.Forwarder.Status.OutputConditions).To(HaveCondition(obs.ConditionTypeAvailableOutputPrefix+"-loki", false, obs.ReasonOutputUnavailable, "3 errors"))
+		Expect(context.Forwarder.Status.PipelineConditions).To(HaveCondition(obs.ConditionTypeFailoverPrefix+"-failover", true, obs.ReasonFailoverOutputActive, `output "s3" is active`))
+		Expect(context.AdditionalContext[framework.OptionFailoverOutputs]).To(Equal(map[string]string{"failover": "s3"}))
+	})
+
+	It("should prefer the delivery reported by the collector to the probe", func() {
+		delivering("loki", true)
+		evaluate(observability.NewOutputProber(probe("loki")))
+		Expect(context.Forwarder.Status.OutputConditions).To(HaveCondition(obs.ConditionTypeAvailableOutputPrefix+"-loki", true, obs.ReasonOutputAvailable, "3 errors"))
+		Expect(context.AdditionalContext[framework.OptionFailoverOutputs]).To(Equal(map[string]string{"failover": "loki"}))
+	})
+
+	It("should probe the outputs that are not deployed to fail back", func() {
+		evaluate(observability.NewOutputProber(probe("loki")))
+		Expect(context.Forwarder.Status.OutputConditions).To(HaveCondition(obs.ConditionTypeAvailableOutputPrefix+"-loki", false, obs.ReasonOutputUnavailable, "connection refused"))
+		Expect(context.AdditionalContext[framework.OptionFailoverOutputs]).To(Equal(map[string]string{"failover": "s3"}))
+
+		evaluate(observability.NewOutputProber(probe()))
+		Expect(context.Forwarder.Status.OutputConditions).To(HaveCondition(obs.ConditionTypeAvailableOutputPrefix+"-loki", true, obs.ReasonOutputAvailable, "accepts connections"))
+		Expect(context.AdditionalContext[framework.OptionFailoverOutputs]).To(Equal(map[string]string{"failover": "loki"}))
+	})
+
+	It("should not fail back before the output has been unavailable for the unavailable duration", func() {
+		context.Forwarder.Spec.Pipelines[0].Failover.UnavailableDuration = &metav1.Duration{Duration: time.Hour}
+		delivering("loki", false)
+		evaluate(observability.NewOutputProber(probe()))
+		meta.RemoveStatusCondition(&context.Forwarder.Status.OutputConditions, obs.ConditionTypeDeliveringOutputPrefix+"-loki")
+		evaluate(observability.NewOutputProber(probe()))
+		Expect(context.Forwarder.Status.OutputConditions).To(HaveCondition(obs.ConditionTypeAvailableOutputPrefix+"-loki", false, obs.ReasonOutputUnavailable, "3 errors"))
+	})
+
+	It("should not report availability or failover for outputs and pipelines without a failover group", func() {
+		evaluate(observability.NewOutputProber(probe()))
+		Expect(meta.FindStatusCondition(context.Forwarder.Status.OutputConditions, obs.ConditionTypeAvailableOutputPrefix+"-es")).To(BeNil())
+		Expect(meta.FindStatusCondition(context.Forwarder.Status.PipelineConditions, obs.ConditionTypeFailoverPrefix+"-plain")).To(BeNil())
+	})
+
+	It("should consider outputs without an endpoint available", func() {
+		Expect(observability.ProbeOutput(obs.OutputSpec{Type: obs.OutputTypeCloudwatch, Cloudwatch: &obs.Cloudwatch{}})).To(Succeed())
+	})
+})
@@ -0,0 +1,69 @@
+package eventwatcher
+
+import (
+	"context"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationv1client "k8s.io/client-go/kubernetes/typed/coordination/v1"
+)
+
+// CheckpointAnnotation is the annotation of the checkpoint lease holding the resource version of the last change of the
+// events observed
+const CheckpointAnnotation = "logging.openshift.io/last-observed-resource-version"
+
+// Checkpoint stores the resource version of the last change of the events observed by the leader so the next leader
+// resumes watching after it. It is kept in a lease separate from the lease of the leader election so that
+// saving it does not conflict with the renewals of the leader
+type Checkpoint struct {
+	leases coordinationv1client.LeaseInterface
+	name   string
+}
+
+func NewCheckpoint(leases coordinationv1client.LeasesGetter, namespace, leaseName string) *Checkpoint {
+	return &Checkpoint{
+		leases: leases.Leases(namespace),
+		name:   leaseName + "-checkpoint",
+	}
+}
+
+// Load returns the saved resource version or an empty string when none was saved
+func (c *Checkpoint) Load(ctx context.Context) (string, error) {
+	lease, err := c.leases.Get(ctx, c.name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return lease.Annotations[CheckpointAnnotation], nil
+}
+
+// Save stores the resource version of the last change of the events observed. Resource versions are opaque so the
+// version of the current leader replaces the saved version
+func (c *Checkpoint) Save(ctx context.Context, version string) error {
+	lease, err := c.leases.Get(ctx, c.name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        c.name,
+				Annotations: map[string]string{CheckpointAnnotation: version},
+			},
+		}
+		_, err = c.leases.Create(ctx, lease, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if lease.Annotations[CheckpointAnnotation] == version {
+		return nil
+	}
+	if lease.Annotations == nil {
+		lease.Annotations = map[string]string{}
+	}
+	lease.Annotations[CheckpointAnnotation] = version
+	_, err = c.leases.Update(ctx, lease, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,41 @@
+package eventwatcher
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var _ = Describe("Checkpoint", func() {
+
+	var (
+		clientset  *fake.Clientset
+		checkpoint *Checkpoint
+	)
+
+	BeforeEach(func() {
+		clientset = fake.NewSimpleClientset()
+		checkpoint = NewCheckpoint(clientset.CoordinationV1(), "openshift-logging", "eventrouter-my-forwarder-myevents")
+	})
+
+	It("should load nothing when no checkpoint was saved", func() {
+		Expect(checkpoint.Load(context.TODO())).To(BeEmpty())
+	})
+
+	It("should save the resource version in a lease separate from the leader election", func() {
+		Expect(checkpoint.Save(context.TODO(), "42")).To(Succeed())
+		lease, err := clientset.CoordinationV1().Leases("openshift-logging").Get(context.TODO(), "eventrouter-my-forwarder-myevents-checkpoint", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lease.Annotations).To(HaveKeyWithValue(CheckpointAnnotation, "42"))
+		Expect(checkpoint.Load(context.TODO())).To(Equal("42"))
+	})
+
+	It("should replace the saved resource version without comparing versions", func() {
+		Expect(checkpoint.Save(context.TODO(), "43")).To(Succeed())
+		Expect(checkpoint.Save(context.TODO(), "42")).To(Succeed())
+		Expect(checkpoint.Load(context.TODO())).To(Equal("42"))
+	})
+})
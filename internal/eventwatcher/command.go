@@ -0,0 +1,202 @@
+package eventwatcher
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"strings"
+	"time"
+
+	logerr "github.com/ViaQ/logerr/v2/log"
+	log "github.com/ViaQ/logerr/v2/log/static"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	watchtools "k8s.io/client-go/tools/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+)
+
+// Command is the argument of the operator binary that runs the event watcher
+const Command = "event-watcher"
+
+const (
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+
+	// checkpointPeriod is the interval at which the resource version of the last change of the events observed is
+	// saved. Events written since the last checkpoint are written again by the next leader
+	checkpointPeriod  = 10 * time.Second
+	checkpointTimeout = 5 * time.Second
+)
+
+// Main runs the event watcher once it is elected leader. Events are written to stdout and the logs of the watcher
+// to stderr so the collector can tell them apart
+func Main(args []string) error {
+	flags := flag.NewFlagSet(Command, flag.ContinueOnError)
+	namespaces := flags.String("namespaces", "", "Comma separated globs of the namespaces of the events to write. All namespaces when empty")
+	excludeNamespaces := flags.String("exclude-namespaces", "", "Comma separated globs of the namespaces of the events not to write")
+	dedupWindow := flags.Duration("dedup-window", DefaultDedupWindow, "The period during which updates of an event that only change its count are not written")
+	leaseName := flags.String("lease-name", "", "The name of the lease of the leader election")
+	leaseNamespace := flags.String("lease-namespace", "", "The namespace of the lease of the leader election")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *leaseName == "" || *leaseNamespace == "" {
+		return errors.New("lease-name and lease-namespace are required")
+	}
+
+	log.SetLogger(logerr.NewLogger(Command, logerr.WithOutput(os.Stderr)))
+
+	restConfig, err := config.GetConfig()
+	if err != nil {
+		return err
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	identity := os.Getenv("POD_NAME")
+	if identity == "" {
+		if identity, err = os.Hostname(); err != nil {
+			return err
+		}
+	}
+
+	watcher := NewWatcher(splitGlobs(*namespaces), splitGlobs(*excludeNamespaces), *dedupWindow, os.Stdout)
+	checkpoint := NewCheckpoint(clientset.CoordinationV1(), *leaseNamespace, *leaseName)
+	ctx := signals.SetupSignalHandler()
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      *leaseName,
+				Namespace: *leaseNamespace,
+			},
+			Client:     clientset.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		},
+		ReleaseOnCancel: true,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Info("Started leading, watching events", "identity", identity)
+				Run(ctx, clientset, watcher, checkpoint)
+			},
+			OnStoppedLeading: func() {
+				log.Info("Stopped leading", "identity", identity)
+			},
+		},
+	})
+	if ctx.Err() == nil {
+		// restart to stand for election again
+		return errors.New("lost the leader election")
+	}
+	return nil
+}
+
+// Run writes the events of the cluster until the context is done. Watching resumes after the resource version saved by
+// the previous leader and the resource version of the last change observed is saved periodically
+func Run(ctx context.Context, clientset kubernetes.Interface, watcher *Watcher, checkpoint *Checkpoint) {
+	saved, err := checkpoint.Load(ctx)
+	if err != nil {
+		log.Error(err, "Unable to load the checkpoint, writing the events that exist")
+	}
+	go watchEvents(ctx, clientset, watcher, saved)
+
+	save := func(ctx context.Context) {
+		if last := watcher.LastResourceVersion(); last != "" && last != saved {
+			if err := checkpoint.Save(ctx, last); err != nil {
+				log.Error(err, "Unable to save the checkpoint")
+				return
+			}
+			saved = last
+		}
+	}
+	ticker := time.NewTicker(checkpointPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			save(ctx)
+		case <-ctx.Done():
+			saveCtx, cancel := context.WithTimeout(context.Background(), checkpointTimeout)
+			save(saveCtx)
+			cancel()
+			return
+		}
+	}
+}
+
+// watchEvents writes the changes of the events after a resource version until the context is done. The events that
+// exist are written, and watched from the resource version of their list, when no version was saved or the watch
+// fails (e.g. the version has expired)
+func watchEvents(ctx context.Context, clientset kubernetes.Interface, watcher *Watcher, version string) {
+	events := clientset.CoreV1().Events(metav1.NamespaceAll)
+	listWatch := &cache.ListWatch{
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return events.Watch(ctx, options)
+		},
+	}
+	for ctx.Err() == nil {
+		if version == "" {
+			list, err := events.List(ctx, metav1.ListOptions{})
+			if err != nil {
+				log.Error(err, "Unable to list events")
+				select {
+				case <-ctx.Done():
+				case <-time.After(retryPeriod):
+				}
+				continue
+			}
+			for i := range list.Items {
+				watcher.OnAdd(&list.Items[i], true)
+			}
+			version = list.ResourceVersion
+		}
+		if err := follow(ctx, listWatch, watcher, version); err != nil {
+			log.Error(err, "Unable to watch events, writing the events that exist", "resourceVersion", version)
+			version = ""
+		}
+	}
+}
+
+// follow writes the changes of the events watched from a resource version until the context is done or the watch fails
+func follow(ctx context.Context, events cache.Watcher, watcher *Watcher, version string) error {
+	retryWatcher, err := watchtools.NewRetryWatcher(version, events)
+	if err != nil {
+		return err
+	}
+	defer retryWatcher.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-retryWatcher.ResultChan():
+			if !ok {
+				return errors.New("the watch of the events stopped")
+			}
+			if e.Type == watch.Error {
+				return apierrors.FromObject(e.Object)
+			}
+			watcher.Handle(e)
+		}
+	}
+}
+
+func splitGlobs(value string) []string {
+	globs := []string{}
+	for _, glob := range strings.Split(value, ",") {
+		if glob = strings.TrimSpace(glob); glob != "" {
+			globs = append(globs, glob)
+		}
+	}
+	return globs
+}
@@ -0,0 +1,166 @@
+package eventwatcher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/auth"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/reconcile"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	replicas = 2
+	binary   = "/usr/bin/cluster-logging-operator"
+)
+
+// Reconcile reconciles the event watcher deployment of a forwarder with events inputs and removes it otherwise
+func Reconcile(k8sClient client.Client, forwarder obs.ClusterLogForwarder, resNames factory.ForwarderResourceNames, owner metav1.OwnerReference) error {
+	inputs := eventsInputs(forwarder.Spec.Inputs)
+	if len(inputs) == 0 {
+		// the role is created first and removed last so there is nothing to remove without it
+		role := runtime.NewRole(forwarder.Namespace, resNames.EventWatcher)
+		if err := k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(role), role); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return Remove(k8sClient, forwarder.Namespace, resNames.EventWatcher)
+	}
+	role := NewRole(forwarder.Namespace, resNames.EventWatcher, owner)
+	if err := reconcile.Role(k8sClient, role); err != nil {
+		return err
+	}
+	roleBinding := NewRoleBinding(forwarder.Namespace, resNames.EventWatcher, resNames.ServiceAccount, owner)
+	if err := reconcile.RoleBinding(k8sClient, roleBinding); err != nil {
+		return err
+	}
+	desired := NewDeployment(forwarder.Namespace, resNames, inputs)
+	utils.AddOwnerRefToObject(desired, owner)
+	return reconcile.Deployment(k8sClient, desired)
+}
+
+// Remove deletes the event watcher deployment and its RBAC
+func Remove(k8sClient client.Client, namespace, name string) error {
+	log.V(3).Info("Removing event watcher", "namespace", namespace, "name", name)
+	for _, o := range []client.Object{
+		runtime.NewDeployment(namespace, name),
+		runtime.NewRoleBinding(namespace, name, rbacv1.RoleRef{}),
+		runtime.NewRole(namespace, name),
+	} {
+		if err := k8sClient.Delete(context.TODO(), o); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failure deleting %T %s/%s: %v", o, namespace, name, err)
+		}
+	}
+	return nil
+}
+
+// NewDeployment stubs the event watcher deployment of a forwarder with a container per events input. Pods are named
+// after the eventrouter to be normalized like its logs
+func NewDeployment(namespace string, resNames factory.ForwarderResourceNames, inputs []obs.InputSpec) *apps.Deployment {
+	podSpec := corev1.PodSpec{
+		NodeSelector:                  utils.EnsureLinuxNodeSelector(nil),
+		ServiceAccountName:            resNames.ServiceAccount,
+		TerminationGracePeriodSeconds: utils.GetPtr[int64](10),
+		SecurityContext: &corev1.PodSecurityContext{
+			RunAsNonRoot: utils.GetPtr(true),
+		},
+	}
+	for _, input := range inputs {
+		podSpec.Containers = append(podSpec.Containers, newContainer(namespace, resNames.EventWatcher, input))
+	}
+	return factory.NewDeployment(namespace, resNames.EventWatcher, constants.EventWatcherName, constants.EventWatcherName, replicas, podSpec, func(o runtime.Object) {
+		runtime.SetCommonLabels(o, constants.EventWatcherName, resNames.EventWatcher, constants.EventWatcherName)
+	})
+}
+
+// newContainer stubs the container of an events input. The arguments are passed in the environment so changes to them
+// roll the deployment
+func newContainer(namespace, name string, input obs.InputSpec) corev1.Container {
+	container := runtime.NewContainer(input.Name, utils.GetComponentImage(constants.EventWatcherName), corev1.PullIfNotPresent, nil)
+	container.Command = []string{binary, Command}
+	container.Args = []string{
+		"--lease-name=$(LEASE_NAME)",
+		"--lease-namespace=$(LEASE_NAMESPACE)",
+		"--namespaces=$(NAMESPACES)",
+		"--exclude-namespaces=$(EXCLUDE_NAMESPACES)",
+	}
+	var namespaces, excludeNamespaces []string
+	if input.Events != nil {
+		namespaces = input.Events.Namespaces
+		excludeNamespaces = input.Events.ExcludeNamespaces
+	}
+	container.Env = []corev1.EnvVar{
+		{Name: "LEASE_NAME", Value: fmt.Sprintf("%s-%s", name, input.Name)},
+		{Name: "LEASE_NAMESPACE", Value: namespace},
+		{Name: "NAMESPACES", Value: strings.Join(namespaces, ",")},
+		{Name: "EXCLUDE_NAMESPACES", Value: strings.Join(excludeNamespaces, ",")},
+		{Name: "POD_NAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.name"}}},
+	}
+	container.SecurityContext = &corev1.SecurityContext{
+		Capabilities: &corev1.Capabilities{
+			Drop: auth.RequiredDropCapabilities,
+		},
+		ReadOnlyRootFilesystem:   utils.GetPtr(true),
+		AllowPrivilegeEscalation: utils.GetPtr(false),
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+	}
+	return *container
+}
+
+// NewRole stubs a role to hold the leases of the leader elections of the event watcher
+func NewRole(namespace, name string, owner metav1.OwnerReference) *rbacv1.Role {
+	desired := runtime.NewRole(namespace, name,
+		runtime.NewPolicyRule(
+			[]string{"coordination.k8s.io"},
+			[]string{"leases"},
+			nil,
+			[]string{"get", "create", "update"},
+		),
+	)
+	utils.AddOwnerRefToObject(desired, owner)
+	return desired
+}
+
+// NewRoleBinding stubs a binding of the event watcher role to the service account of the forwarder
+func NewRoleBinding(namespace, name, saName string, owner metav1.OwnerReference) *rbacv1.RoleBinding {
+	desired := runtime.NewRoleBinding(namespace, name,
+		rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     name,
+		},
+		rbacv1.Subject{
+			Kind:      "ServiceAccount",
+			Name:      saName,
+			Namespace: namespace,
+		},
+	)
+	utils.AddOwnerRefToObject(desired, owner)
+	return desired
+}
+
+func eventsInputs(inputs []obs.InputSpec) []obs.InputSpec {
+	events := []obs.InputSpec{}
+	for _, input := range inputs {
+		if input.Type == obs.InputTypeEvents {
+			events = append(events, input)
+		}
+	}
+	return events
+}
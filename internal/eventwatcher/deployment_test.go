@@ -0,0 +1,110 @@
+package eventwatcher
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("event watcher deployment", func() {
+
+	var (
+		forwarder obs.ClusterLogForwarder
+		resNames  *factory.ForwarderResourceNames
+		k8sClient client.Client
+		key       types.NamespacedName
+	)
+
+	BeforeEach(func() {
+		forwarder = obs.ClusterLogForwarder{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-forwarder",
+				Namespace: constants.OpenshiftNS,
+			},
+			Spec: obs.ClusterLogForwarderSpec{
+				ServiceAccount: obs.ServiceAccount{Name: "logcollector"},
+				Inputs: []obs.InputSpec{
+					{Name: "myapp", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+					{
+						Name: "myevents",
+						Type: obs.InputTypeEvents,
+						Events: &obs.Events{
+							Namespaces:        []string{"my-*", "shared"},
+							ExcludeNamespaces: []string{"my-sandbox"},
+						},
+					},
+				},
+			},
+		}
+		resNames = factory.ResourceNames(forwarder)
+		k8sClient = fake.NewClientBuilder().Build()
+		key = types.NamespacedName{Namespace: constants.OpenshiftNS, Name: "eventrouter-my-forwarder"}
+	})
+
+	It("should stub a pod named after the eventrouter with a container for each events input", func() {
+		dpl := NewDeployment(forwarder.Namespace, *resNames, eventsInputs(forwarder.Spec.Inputs))
+		Expect(dpl.Name).To(Equal("eventrouter-my-forwarder"))
+		for name, value := range dpl.Spec.Selector.MatchLabels {
+			Expect(dpl.Spec.Template.Labels).To(HaveKeyWithValue(name, value))
+		}
+		podSpec := dpl.Spec.Template.Spec
+		Expect(podSpec.ServiceAccountName).To(Equal("logcollector"))
+		Expect(podSpec.Containers).To(HaveLen(1))
+		container := podSpec.Containers[0]
+		Expect(container.Name).To(Equal("myevents"))
+		Expect(container.Command).To(Equal([]string{binary, Command}))
+		Expect(container.Env).To(ContainElements(
+			corev1.EnvVar{Name: "LEASE_NAME", Value: "eventrouter-my-forwarder-myevents"},
+			corev1.EnvVar{Name: "LEASE_NAMESPACE", Value: constants.OpenshiftNS},
+			corev1.EnvVar{Name: "NAMESPACES", Value: "my-*,shared"},
+			corev1.EnvVar{Name: "EXCLUDE_NAMESPACES", Value: "my-sandbox"},
+		))
+	})
+
+	It("should reconcile the deployment and the RBAC of the leader election", func() {
+		Expect(Reconcile(k8sClient, forwarder, *resNames, utils.AsOwner(&forwarder))).To(Succeed())
+		Expect(k8sClient.Get(context.TODO(), key, &apps.Deployment{})).To(Succeed())
+		role := &rbacv1.Role{}
+		Expect(k8sClient.Get(context.TODO(), key, role)).To(Succeed())
+		Expect(role.Rules[0].Resources).To(Equal([]string{"leases"}))
+		binding := &rbacv1.RoleBinding{}
+		Expect(k8sClient.Get(context.TODO(), key, binding)).To(Succeed())
+		Expect(binding.Subjects[0].Name).To(Equal("logcollector"))
+	})
+
+	It("should remove the deployment and the RBAC when there are no events inputs", func() {
+		Expect(Reconcile(k8sClient, forwarder, *resNames, utils.AsOwner(&forwarder))).To(Succeed())
+		forwarder.Spec.Inputs = forwarder.Spec.Inputs[:1]
+		Expect(Reconcile(k8sClient, forwarder, *resNames, utils.AsOwner(&forwarder))).To(Succeed())
+		Expect(apierrors.IsNotFound(k8sClient.Get(context.TODO(), key, &apps.Deployment{}))).To(BeTrue())
+		Expect(apierrors.IsNotFound(k8sClient.Get(context.TODO(), key, &rbacv1.Role{}))).To(BeTrue())
+		Expect(apierrors.IsNotFound(k8sClient.Get(context.TODO(), key, &rbacv1.RoleBinding{}))).To(BeTrue())
+	})
+
+	It("should not delete anything when there are no events inputs and no event watcher", func() {
+		forwarder.Spec.Inputs = forwarder.Spec.Inputs[:1]
+		deletes := 0
+		k8sClient = fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				deletes++
+				return c.Delete(ctx, obj, opts...)
+			},
+		}).Build()
+		Expect(Reconcile(k8sClient, forwarder, *resNames, utils.AsOwner(&forwarder))).To(Succeed())
+		Expect(deletes).To(BeZero())
+	})
+})
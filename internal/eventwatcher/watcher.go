@@ -0,0 +1,213 @@
+package eventwatcher
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	VerbAdded   = "ADDED"
+	VerbUpdated = "UPDATED"
+
+	// DefaultDedupWindow is the period during which updates of an event that only change its count are not written
+	DefaultDedupWindow = time.Minute
+)
+
+// Record is an event in the format of the eventrouter
+type Record struct {
+	Verb     string        `json:"verb"`
+	Event    *corev1.Event `json:"event"`
+	OldEvent *corev1.Event `json:"old_event,omitempty"`
+}
+
+// written is the content of the last record written for an event
+type written struct {
+	eventType string
+	reason    string
+	message   string
+	count     int32
+	at        time.Time
+}
+
+// Watcher writes the events of the selected namespaces to an output, one record per line. Events are identified by
+// their UID and count so the same occurrence of an event is written once. Repeated updates of an event are written at
+// most once per dedup window unless their type, reason or message change
+type Watcher struct {
+	namespaces        []string
+	excludeNamespaces []string
+	dedupWindow       time.Duration
+	out               io.Writer
+	now               func() time.Time
+
+	mu      sync.Mutex
+	written map[types.UID]written
+	// seen is the last version of each event, which is the old event of its next update
+	seen map[types.UID]*corev1.Event
+	// lastSeen is the resource version of the last change of the events that was observed
+	lastSeen string
+}
+
+var _ cache.ResourceEventHandler = &Watcher{}
+
+func NewWatcher(namespaces, excludeNamespaces []string, dedupWindow time.Duration, out io.Writer) *Watcher {
+	return &Watcher{
+		namespaces:        namespaces,
+		excludeNamespaces: excludeNamespaces,
+		dedupWindow:       dedupWindow,
+		out:               out,
+		now:               time.Now,
+		written:           map[types.UID]written{},
+		seen:              map[types.UID]*corev1.Event{},
+	}
+}
+
+// Selects returns true if the events of the namespace are written. Excluded namespaces take precedence and all
+// namespaces are selected when none are spec'd
+func (w *Watcher) Selects(namespace string) bool {
+	if utils.MatchesAnyGlob(namespace, w.excludeNamespaces...) {
+		return false
+	}
+	return len(w.namespaces) == 0 || utils.MatchesAnyGlob(namespace, w.namespaces...)
+}
+
+// LastResourceVersion returns the resource version of the last change of the events that was observed. Watching
+// from this version resumes after the last change. Resource versions are opaque and only passed back to the API server
+func (w *Watcher) LastResourceVersion() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.lastSeen
+}
+
+// Handle writes the event of a watch
+func (w *Watcher) Handle(e watch.Event) {
+	event, ok := e.Object.(*corev1.Event)
+	if !ok {
+		return
+	}
+	switch e.Type {
+	case watch.Added:
+		w.OnAdd(event, false)
+	case watch.Modified:
+		w.mu.Lock()
+		oldEvent := w.seen[event.UID]
+		w.mu.Unlock()
+		w.OnUpdate(oldEvent, event)
+	case watch.Deleted:
+		w.OnDelete(event)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lastSeen = event.ResourceVersion
+}
+
+func (w *Watcher) OnAdd(obj interface{}, _ bool) {
+	event, ok := obj.(*corev1.Event)
+	if !ok {
+		return
+	}
+	if !w.Selects(event.Namespace) {
+		return
+	}
+	w.see(event)
+	if w.isDuplicate(event) {
+		return
+	}
+	w.write(Record{Verb: VerbAdded, Event: event})
+}
+
+// OnUpdate writes an update of an event. The old event is nil when the previous version was not seen
+func (w *Watcher) OnUpdate(oldObj, newObj interface{}) {
+	event, ok := newObj.(*corev1.Event)
+	if !ok {
+		return
+	}
+	if !w.Selects(event.Namespace) {
+		return
+	}
+	w.see(event)
+	if w.isDuplicate(event) || w.isRepeated(event) {
+		return
+	}
+	oldEvent, _ := oldObj.(*corev1.Event)
+	w.write(Record{Verb: VerbUpdated, Event: event, OldEvent: oldEvent})
+}
+
+func (w *Watcher) OnDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if event, ok := obj.(*corev1.Event); ok {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.written, event.UID)
+		delete(w.seen, event.UID)
+	}
+}
+
+func (w *Watcher) see(event *corev1.Event) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.seen[event.UID] = event
+}
+
+// isDuplicate returns true if the same occurrence of the event was already written
+func (w *Watcher) isDuplicate(event *corev1.Event) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	last, found := w.written[event.UID]
+	return found &&
+		last.count == count(event) &&
+		last.eventType == event.Type &&
+		last.reason == event.Reason &&
+		last.message == event.Message
+}
+
+// isRepeated returns true if the event only changed its count since it was last written within the dedup window
+func (w *Watcher) isRepeated(event *corev1.Event) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	last, found := w.written[event.UID]
+	return found &&
+		last.eventType == event.Type &&
+		last.reason == event.Reason &&
+		last.message == event.Message &&
+		w.now().Sub(last.at) < w.dedupWindow
+}
+
+func (w *Watcher) write(record Record) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		log.Error(err, "Unable to marshal event", "namespace", record.Event.Namespace, "name", record.Event.Name)
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, err = w.out.Write(append(line, '\n')); err != nil {
+		log.Error(err, "Unable to write event", "namespace", record.Event.Namespace, "name", record.Event.Name)
+		return
+	}
+	w.written[record.Event.UID] = written{
+		eventType: record.Event.Type,
+		reason:    record.Event.Reason,
+		message:   record.Event.Message,
+		count:     count(record.Event),
+		at:        w.now(),
+	}
+}
+
+// count returns the number of occurrences of an event
+func count(event *corev1.Event) int32 {
+	if event.Series != nil {
+		return event.Series.Count
+	}
+	return event.Count
+}
@@ -0,0 +1,152 @@
+package eventwatcher
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ = Describe("Watcher", func() {
+
+	var (
+		out     *bytes.Buffer
+		now     time.Time
+		watcher *Watcher
+	)
+
+	newEvent := func(resourceVersion string, count int32, message string) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "mypod.17c3",
+				Namespace:       "myproject",
+				UID:             "b6a7c2f2-0d14-4c4b-a8a5-3c2e4d8a2f10",
+				ResourceVersion: resourceVersion,
+			},
+			Type:    corev1.EventTypeWarning,
+			Reason:  "BackOff",
+			Message: message,
+			Count:   count,
+		}
+	}
+
+	records := func() []Record {
+		result := []Record{}
+		for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			record := Record{}
+			Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+			result = append(result, record)
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		now = time.Now()
+		watcher = NewWatcher(nil, nil, time.Minute, out)
+		watcher.now = func() time.Time { return now }
+	})
+
+	DescribeTable("#Selects", func(namespaces, excludeNamespaces []string, namespace string, exp bool) {
+		Expect(NewWatcher(namespaces, excludeNamespaces, time.Minute, out).Selects(namespace)).To(Equal(exp))
+	},
+		Entry("should select all namespaces by default", nil, nil, "myproject", true),
+		Entry("should select namespaces matching a glob", []string{"my*"}, nil, "myproject", true),
+		Entry("should not select namespaces not matching a glob", []string{"openshift-*"}, nil, "myproject", false),
+		Entry("should not select excluded namespaces", nil, []string{"my*"}, "myproject", false),
+		Entry("should not select excluded namespaces that are also selected", []string{"my*"}, []string{"myproject"}, "myproject", false),
+	)
+
+	It("should write added events in the format of the eventrouter", func() {
+		watcher.OnAdd(newEvent("1", 1, "Back-off restarting failed container"), false)
+		Expect(out.String()).To(HavePrefix(`{"verb":"ADDED","event":{`))
+		Expect(records()).To(HaveLen(1))
+		Expect(records()[0].Event.Message).To(Equal("Back-off restarting failed container"))
+		Expect(records()[0].OldEvent).To(BeNil())
+	})
+
+	It("should not write events of namespaces that are not selected", func() {
+		watcher = NewWatcher(nil, []string{"my*"}, time.Minute, out)
+		watcher.OnAdd(newEvent("1", 1, "Back-off restarting failed container"), false)
+		watcher.OnUpdate(newEvent("1", 1, "Back-off restarting failed container"), newEvent("2", 2, "Back-off restarting failed container"))
+		Expect(out.String()).To(BeEmpty())
+	})
+
+	It("should write updated events with the old event", func() {
+		watcher.OnAdd(newEvent("1", 1, "Pulling image"), false)
+		watcher.OnUpdate(newEvent("1", 1, "Pulling image"), newEvent("2", 1, "Back-off pulling image"))
+		Expect(records()).To(HaveLen(2))
+		Expect(records()[1].Verb).To(Equal(VerbUpdated))
+		Expect(records()[1].Event.Message).To(Equal("Back-off pulling image"))
+		Expect(records()[1].OldEvent.Message).To(Equal("Pulling image"))
+	})
+
+	It("should not write the same occurrence of an event again", func() {
+		event := newEvent("1", 1, "Back-off restarting failed container")
+		watcher.OnAdd(event, false)
+		watcher.OnUpdate(event, event)
+		watcher.OnAdd(newEvent("1", 1, "Back-off restarting failed container"), true)
+		Expect(records()).To(HaveLen(1))
+	})
+
+	It("should write a listed event that occurred again since it was written", func() {
+		watcher.OnAdd(newEvent("1", 1, "Back-off restarting failed container"), false)
+		watcher.OnAdd(newEvent("2", 2, "Back-off restarting failed container"), true)
+		Expect(records()).To(HaveLen(2))
+		Expect(records()[1].Event.Count).To(BeEquivalentTo(2))
+	})
+
+	It("should not write updates that only change the count within the dedup window", func() {
+		watcher.OnAdd(newEvent("1", 1, "Back-off restarting failed container"), false)
+		watcher.OnUpdate(newEvent("1", 1, "Back-off restarting failed container"), newEvent("2", 2, "Back-off restarting failed container"))
+		now = now.Add(30 * time.Second)
+		watcher.OnUpdate(newEvent("2", 2, "Back-off restarting failed container"), newEvent("3", 3, "Back-off restarting failed container"))
+		Expect(records()).To(HaveLen(1))
+
+		now = now.Add(31 * time.Second)
+		watcher.OnUpdate(newEvent("3", 3, "Back-off restarting failed container"), newEvent("4", 4, "Back-off restarting failed container"))
+		Expect(records()).To(HaveLen(2))
+		Expect(records()[1].Event.Count).To(BeEquivalentTo(4))
+	})
+
+	It("should write repeated updates of an event that was deleted", func() {
+		watcher.OnAdd(newEvent("1", 1, "Back-off restarting failed container"), false)
+		watcher.OnDelete(cache.DeletedFinalStateUnknown{Obj: newEvent("1", 1, "Back-off restarting failed container")})
+		watcher.OnUpdate(newEvent("1", 1, "Back-off restarting failed container"), newEvent("2", 2, "Back-off restarting failed container"))
+		Expect(records()).To(HaveLen(2))
+	})
+	It("should write modified events of a watch with the last version seen as the old event", func() {
+		watcher.Handle(watch.Event{Type: watch.Added, Object: newEvent("1", 1, "Pulling image")})
+		watcher.Handle(watch.Event{Type: watch.Modified, Object: newEvent("2", 1, "Back-off pulling image")})
+		Expect(records()).To(HaveLen(2))
+		Expect(records()[1].Verb).To(Equal(VerbUpdated))
+		Expect(records()[1].OldEvent.Message).To(Equal("Pulling image"))
+	})
+
+	It("should write modified events of a watch that were not seen without the old event", func() {
+		watcher.Handle(watch.Event{Type: watch.Modified, Object: newEvent("2", 2, "Back-off pulling image")})
+		Expect(records()).To(HaveLen(1))
+		Expect(records()[0].Verb).To(Equal(VerbUpdated))
+		Expect(records()[0].OldEvent).To(BeNil())
+	})
+
+	It("should track the resource version of the last change observed, including events that are not written", func() {
+		watcher = NewWatcher(nil, []string{"my*"}, time.Minute, out)
+		Expect(watcher.LastResourceVersion()).To(BeEmpty())
+		watcher.Handle(watch.Event{Type: watch.Added, Object: newEvent("7", 1, "Pulling image")})
+		watcher.Handle(watch.Event{Type: watch.Deleted, Object: newEvent("5", 1, "Pulling image")})
+		Expect(watcher.LastResourceVersion()).To(Equal("5"))
+		Expect(out.String()).To(BeEmpty())
+	})
+})
@@ -16,6 +16,7 @@ type ForwarderResourceNames struct {
 	ServiceAccountTokenSecret        string
 	ForwarderName                    string
 	Secrets                          string
+	EventWatcher                     string
 }
 
 func (f *ForwarderResourceNames) DaemonSetName() string {
@@ -41,5 +42,6 @@ func ResourceNames(clf obsv1.ClusterLogForwarder) *ForwarderResourceNames {
 		InternalLogStoreSecret:           clf.Spec.ServiceAccount.Name + "-default",
 		ServiceAccountTokenSecret:        clf.Spec.ServiceAccount.Name + "-token",
 		Secrets:                          resBaseName + "-secrets",
+		EventWatcher:                     "eventrouter-" + resBaseName,
 	}
 }
@@ -12,6 +12,15 @@ const (
 
 	URL                                 = "url"
 	OptionServiceAccountTokenSecretName = "serviceAccountTokenSecretName"
+
+	// OptionFailoverOutputs is a map of pipeline names to the active output of their failover group
+	OptionFailoverOutputs = "failoverOutputs"
+
+	// OptionInfrastructureNamespaces is a list of the additional infrastructure namespaces of the forwarder
+	OptionInfrastructureNamespaces = "infrastructureNamespaces"
+
+	// OptionEventWatcher is the name of the event watcher deployment whose container logs are only read by events inputs
+	OptionEventWatcher = "eventWatcher"
 )
 
 // Options is a map of Options used to customize the config generation. E.g. Debugging, legacy config generation
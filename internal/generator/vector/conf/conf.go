@@ -14,6 +14,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/metrics"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/pipeline"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/source"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -59,6 +60,14 @@ import (
 //nolint:govet // using declarative style
 func Conf(secrets map[string]*corev1.Secret, clfspec obs.ClusterLogForwarderSpec, namespace, forwarderName string, resNames factory.ForwarderResourceNames, op framework.Options) []framework.Section {
 
+	// the additional infrastructure namespaces of the forwarder classify container logs for all inputs
+	if len(clfspec.InfrastructureNamespaces) > 0 {
+		op[framework.OptionInfrastructureNamespaces] = clfspec.InfrastructureNamespaces
+	}
+	if internalobs.Inputs(clfspec.Inputs).HasEventsSource() {
+		op[framework.OptionEventWatcher] = resNames.EventWatcher
+	}
+
 	// Init inputs, outputs, pipelines
 	inputMap := map[string]*input.Input{}
 	inputCompMap := map[string]helpers.InputComponent{}
@@ -76,20 +85,49 @@ func Conf(secrets map[string]*corev1.Secret, clfspec obs.ClusterLogForwarderSpec
 
 	filters := filter.NewInternalFilterMap(internalobs.FilterMap(clfspec))
 	pipelineMap := map[string]*pipeline.Pipeline{}
+	failoverOutputs, _ := utils.GetOption(op, framework.OptionFailoverOutputs, map[string]string{})
 	for i, p := range clfspec.Pipelines {
+		p.OutputRefs = append([]string{}, p.OutputRefs...)
+		if p.Failover != nil {
+			// route to the active output of the failover group, defaulting to the primary output
+			active, found := failoverOutputs[p.Name]
+			if !found {
+				active = p.Failover.OutputRefs[0]
+			}
+			p.OutputRefs = append(p.OutputRefs, active)
+		}
 		a := pipeline.NewPipeline(i, p, inputCompMap, outputMap, filters, clfspec.Inputs)
 		pipelineMap[p.Name] = a
 	}
 
+	// wire the transform failure outputs after the pipelines so the inputs of the rerouting outputs are known
+	for _, o := range sortAdapters(outputMap) {
+		if len(o.Inputs()) == 0 {
+			continue
+		}
+		if dl, found := outputMap[o.TransformFailureOutputRef()]; found {
+			if rerouted := o.TransformFailure(); rerouted != nil {
+				dl.AddInputFrom(rerouted)
+			}
+		}
+	}
+
 	// generate sections, deferring input wiring to config generation
 	sections := framework.Section{}
 	for _, i := range sortAdapters(inputMap) {
 		sections.Elements = append(sections.Elements, i.Elements()...)
 	}
+	// metrics generated by filters are exposed with the collector's internal metrics
+	metricInputs := []string{source.InternalMetricsSourceName}
 	for _, p := range sortAdapters(pipelineMap) {
 		sections.Elements = append(sections.Elements, p.Elements()...)
+		metricInputs = append(metricInputs, p.MetricIDs()...)
 	}
 	for _, o := range sortAdapters(outputMap) {
+		// outputs without inputs (e.g. inactive outputs of a failover group) are not deployed
+		if len(o.Inputs()) == 0 {
+			continue
+		}
 		sections.Elements = append(sections.Elements, o.Elements()...)
 	}
 
@@ -105,7 +143,7 @@ func Conf(secrets map[string]*corev1.Secret, clfspec obs.ClusterLogForwarderSpec
 		sections,
 		{
 			Elements: []framework.Element{
-				metrics.AddNodeNameToMetric(metrics.AddNodenameToMetricTransformName, []string{source.InternalMetricsSourceName}),
+				metrics.AddNodeNameToMetric(metrics.AddNodenameToMetricTransformName, metricInputs),
 				metrics.PrometheusOutput(metrics.PrometheusOutputSinkName, []string{metrics.AddNodenameToMetricTransformName}, minTlsVersion, cipherSuites),
 			},
 		},
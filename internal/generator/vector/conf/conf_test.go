@@ -221,6 +221,43 @@ var _ = Describe("Testing Complete Config Generation", func() {
 			}),
 	)
 
+	Context("with a failover group", func() {
+		var (
+			spec = obs.ClusterLogForwarderSpec{
+				Pipelines: []obs.PipelineSpec{
+					{
+						Name:      "mypipeline",
+						InputRefs: []string{string(obs.InputTypeApplication)},
+						Failover: &obs.FailoverSpec{
+							OutputRefs: []string{"primary", "secondary"},
+						},
+					},
+				},
+				Outputs: []obs.OutputSpec{
+					{Name: "primary", Type: obs.OutputTypeHTTP, HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "http://primary:8080"}}},
+					{Name: "secondary", Type: obs.OutputTypeHTTP, HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "http://secondary:8080"}}},
+				},
+			}
+			generate = func(op framework.Options) string {
+				conf, err := framework.MakeGenerator().GenerateConf(framework.MergeSections(Conf(secrets, spec, constants.OpenshiftNS, "my-forwarder", factory.ForwarderResourceNames{CommonName: constants.CollectorName}, op))...)
+				Expect(err).To(BeNil())
+				return conf
+			}
+		)
+
+		It("should only route to the primary output by default", func() {
+			conf := generate(framework.Options{})
+			Expect(conf).To(ContainSubstring("[sinks.output_primary]"))
+			Expect(conf).ToNot(ContainSubstring("[sinks.output_secondary]"))
+		})
+
+		It("should only route to the active output", func() {
+			conf := generate(framework.Options{framework.OptionFailoverOutputs: map[string]string{"mypipeline": "secondary"}})
+			Expect(conf).To(ContainSubstring("[sinks.output_secondary]"))
+			Expect(conf).ToNot(ContainSubstring("[sinks.output_primary]"))
+		})
+	})
+
 	Describe("test helper functions", func() {
 		It("test MakeInputs", func() {
 			diff := cmp.Diff(helpers.MakeInputs("a", "b"), "[\"a\",\"b\"]")
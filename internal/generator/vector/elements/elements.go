@@ -63,6 +63,9 @@ type Remap struct {
 	Desc        string
 	Inputs      string
 	VRL         string
+
+	// RerouteDropped routes events that fail processing to the `<ComponentID>.dropped` output instead of passing them on unmodified
+	RerouteDropped bool
 }
 
 func (r Remap) Name() string {
@@ -77,6 +80,10 @@ func (r Remap) Template() string {
 [transforms.{{.ComponentID}}]
 type = "remap"
 inputs = {{.Inputs}}
+{{if .RerouteDropped -}}
+drop_on_error = true
+reroute_dropped = true
+{{end -}}
 source = '''
 {{.VRL | indent 2}}
 '''
@@ -1,11 +1,20 @@
 package drop
 
 import (
+	"errors"
 	"fmt"
-	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"regexp"
+	"strconv"
 	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
+// levels are the normalized log levels ordered from the most to the least severe
+var levels = []string{"emergency", "alert", "critical", "error", "warn", "notice", "info", "debug", "trace"}
+
 type Filter struct {
 	tests []obs.DropTest
 }
@@ -16,15 +25,26 @@ func NewFilter(dropTestsSpec []obs.DropTest) *Filter {
 }
 
 func (f *Filter) VRL() (string, error) {
+	vrlTests, err := Tests(f.tests)
+	if err != nil {
+		return "", err
+	}
+	// Vector's transform.Filter keeps logs that match the condition
+	// Need `!()` to negate the whole expression if any condition evaluates to TRUE to drop logs
+	return "!" + vrlTests, nil
+}
+
+// Tests returns the VRL expression that evaluates to true when any of the tests pass
+func Tests(tests []obs.DropTest) (string, error) {
 	vrlTests := []string{}
-	for _, test := range f.tests {
+	for i, test := range tests {
 		condList := []string{}
 		for _, cond := range test.DropConditions {
-			if cond.Matches != "" {
-				condList = append(condList, fmt.Sprintf(`match(to_string(%s) ?? "", r'%s')`, cond.Field, cond.Matches))
-			} else {
-				condList = append(condList, fmt.Sprintf(`!match(to_string(%s) ?? "", r'%s')`, cond.Field, cond.NotMatches))
+			vrlCond, err := Condition(cond)
+			if err != nil {
+				return "", fmt.Errorf("test[%d] %v", i, err)
 			}
+			condList = append(condList, vrlCond)
 		}
 		// Concatenate the conditions with ANDs and add Vector's error coalescing.
 		// If any errors arise from the match such as, `cond.Field` not being a string or a field
@@ -32,8 +52,130 @@ func (f *Filter) VRL() (string, error) {
 		vrlCondition := "(" + strings.Join(condList, " && ") + ")"
 		vrlTests = append(vrlTests, vrlCondition)
 	}
+	return "(" + strings.Join(vrlTests, " || ") + ")", nil
+}
 
-	// Vector's transform.Filter keeps logs that match the condition
-	// Need `!()` to negate the whole expression if any condition evaluates to TRUE to drop logs
-	return "!(" + strings.Join(vrlTests, " || ") + ")", nil
+// Condition returns the VRL expression of a single drop condition that evaluates to true when the record should be dropped
+func Condition(cond obs.DropCondition) (string, error) {
+	if operators(cond) > 1 {
+		return "", errors.New("only one of matches, notMatches, exists, equals, in, greaterThan, lessThan or levelBelow can be defined at once")
+	}
+	value := fmt.Sprintf(`(to_string(%s) ?? "")`, cond.Field)
+	if cond.CaseInsensitive {
+		value = fmt.Sprintf(`downcase(to_string(%s) ?? "")`, cond.Field)
+	}
+	switch {
+	case cond.Exists != nil:
+		if *cond.Exists {
+			return fmt.Sprintf(`exists(%s)`, cond.Field), nil
+		}
+		return fmt.Sprintf(`!exists(%s)`, cond.Field), nil
+	case cond.Equals != nil:
+		return fmt.Sprintf(`%s == %s`, value, stringLiteral(*cond.Equals, cond.CaseInsensitive)), nil
+	case len(cond.In) > 0:
+		literals := make([]string, len(cond.In))
+		for i, v := range cond.In {
+			literals[i] = stringLiteral(v, cond.CaseInsensitive)
+		}
+		return fmt.Sprintf(`includes([%s], %s)`, strings.Join(literals, ","), value), nil
+	case cond.GreaterThan != nil:
+		// Non-numeric values coalesce to the bound so the comparison is false
+		bound := number(*cond.GreaterThan)
+		return fmt.Sprintf(`(!is_null(%s) && (to_float(%s) ?? %s) > %s)`, cond.Field, cond.Field, bound, bound), nil
+	case cond.LessThan != nil:
+		bound := number(*cond.LessThan)
+		return fmt.Sprintf(`(!is_null(%s) && (to_float(%s) ?? %s) < %s)`, cond.Field, cond.Field, bound, bound), nil
+	case cond.LevelBelow != "":
+		below, err := levelsBelow(cond.LevelBelow)
+		if err != nil {
+			return "", err
+		}
+		literals := make([]string, len(below))
+		for i, level := range below {
+			literals[i] = helpers.VRLString(level)
+		}
+		return fmt.Sprintf(`includes([%s], downcase(to_string(%s) ?? ""))`, strings.Join(literals, ","), cond.Field), nil
+	case cond.Matches != "":
+		pattern, err := regexLiteral(cond.Matches, cond.CaseInsensitive)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(`match(to_string(%s) ?? "", %s)`, cond.Field, pattern), nil
+	}
+	pattern, err := regexLiteral(cond.NotMatches, cond.CaseInsensitive)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`!match(to_string(%s) ?? "", %s)`, cond.Field, pattern), nil
+}
+
+func operators(cond obs.DropCondition) (count int) {
+	for _, defined := range []bool{
+		cond.Matches != "",
+		cond.NotMatches != "",
+		cond.Exists != nil,
+		cond.Equals != nil,
+		len(cond.In) > 0,
+		cond.GreaterThan != nil,
+		cond.LessThan != nil,
+		cond.LevelBelow != "",
+	} {
+		if defined {
+			count++
+		}
+	}
+	return count
+}
+
+// number is the VRL literal of a numeric bound
+func number(q resource.Quantity) string {
+	return strconv.FormatFloat(q.AsApproximateFloat64(), 'f', -1, 64)
+}
+
+// levelsBelow returns the levels that are less severe than a level
+func levelsBelow(level string) ([]string, error) {
+	for i, l := range levels {
+		if l == strings.ToLower(level) {
+			return levels[i+1:], nil
+		}
+	}
+	return nil, fmt.Errorf("levelBelow must be one of %s", strings.Join(levels, ", "))
+}
+
+func stringLiteral(value string, caseInsensitive bool) string {
+	if caseInsensitive {
+		value = strings.ToLower(value)
+	}
+	return helpers.VRLString(value)
+}
+
+func regexLiteral(pattern string, caseInsensitive bool) (string, error) {
+	if strings.Contains(pattern, "'") {
+		return "", fmt.Errorf("regular expression %q can not contain a single quote (')", pattern)
+	}
+	if caseInsensitive {
+		pattern = "(?i)" + pattern
+	}
+	if err := validateRegex(pattern); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`r'%s'`, pattern), nil
+}
+
+// validateRegex verifies a regular expression is valid for the regex engine of VRL. The syntax of the engine is the
+// RE2 syntax parsed by regexp except for the \Q...\E literal text escapes, which are not supported
+func validateRegex(pattern string) error {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return errors.New("matches/notMatches must be a valid regular expression.")
+	}
+	for i := 0; i < len(pattern)-1; i++ {
+		if pattern[i] != '\\' {
+			continue
+		}
+		if pattern[i+1] == 'Q' || pattern[i+1] == 'E' {
+			return fmt.Errorf("regular expression %q can not contain literal text escapes (\\Q...\\E)", pattern)
+		}
+		i++
+	}
+	return nil
 }
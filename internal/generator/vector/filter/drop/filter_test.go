@@ -4,7 +4,9 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	"github.com/openshift/cluster-logging-operator/test/matchers"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 var _ = Describe("drop filter", func() {
@@ -49,6 +51,154 @@ var _ = Describe("drop filter", func() {
 !((match(to_string(.kubernetes.namespace_name) ?? "", r'busybox') && !match(to_string(.level) ?? "", r'd.+')) || (match(to_string(.log_type) ?? "", r'application')) || (match(to_string(.kubernetes.container_name) ?? "", r'error|warning') && !match(to_string(.kubernetes.labels.test) ?? "", r'foo')))
 `))
 		})
+
+		It("should generate valid VRL for existence, equality, list, numeric and case-insensitive conditions", func() {
+			spec := []obs.DropTest{
+				{
+					DropConditions: []obs.DropCondition{
+						{
+							Field:  ".kubernetes.labels.debug",
+							Exists: utils.GetPtr(true),
+						},
+						{
+							Field:           ".level",
+							In:              []string{"Debug", "Trace"},
+							CaseInsensitive: true,
+						},
+					},
+				},
+				{
+					DropConditions: []obs.DropCondition{
+						{
+							Field:  ".hostname",
+							Exists: utils.GetPtr(false),
+						},
+					},
+				},
+				{
+					DropConditions: []obs.DropCondition{
+						{
+							Field:  ".log_type",
+							Equals: utils.GetPtr("application"),
+						},
+						{
+							Field:    ".status",
+							LessThan: utils.GetPtr(resource.MustParse("400")),
+						},
+						{
+							Field:       ".code",
+							GreaterThan: utils.GetPtr(resource.MustParse("99")),
+						},
+						{
+							Field:           ".message",
+							Matches:         "health",
+							CaseInsensitive: true,
+						},
+					},
+				},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+!((exists(.kubernetes.labels.debug) && includes(["debug","trace"], downcase(to_string(.level) ?? ""))) || (!exists(.hostname)) || ((to_string(.log_type) ?? "") == "application" && (!is_null(.status) && (to_float(.status) ?? 400) < 400) && (!is_null(.code) && (to_float(.code) ?? 99) > 99) && match(to_string(.message) ?? "", r'(?i)health')))
+`))
+		})
+
+		It("should generate valid VRL for decimal numeric conditions", func() {
+			spec := []obs.DropTest{
+				{
+					DropConditions: []obs.DropCondition{
+						{
+							Field:       ".duration",
+							GreaterThan: utils.GetPtr(resource.MustParse("0.25")),
+						},
+						{
+							Field:    ".duration",
+							LessThan: utils.GetPtr(resource.MustParse("1.5")),
+						},
+					},
+				},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+!(((!is_null(.duration) && (to_float(.duration) ?? 0.25) > 0.25) && (!is_null(.duration) && (to_float(.duration) ?? 1.5) < 1.5)))
+`))
+		})
+
+		It("should generate valid VRL for level conditions", func() {
+			spec := []obs.DropTest{
+				{
+					DropConditions: []obs.DropCondition{
+						{
+							Field:      ".level",
+							LevelBelow: "warn",
+						},
+					},
+				},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+!((includes(["notice","info","debug","trace"], downcase(to_string(.level) ?? ""))))
+`))
+		})
+
+		It("should fail when a level is unknown", func() {
+			spec := []obs.DropTest{
+				{
+					DropConditions: []obs.DropCondition{
+						{
+							Field:      ".level",
+							LevelBelow: "verbose",
+						},
+					},
+				},
+			}
+			_, err := NewFilter(spec).VRL()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should escape values as VRL string literals", func() {
+			spec := []obs.DropTest{
+				{
+					DropConditions: []obs.DropCondition{
+						{
+							Field: ".message",
+							In:    []string{`say "hi"`, `C:\temp`, `{{ .level }}`, `${HOME}`},
+						},
+					},
+				},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+!((includes(["say \"hi\"","C:\\temp","{{ .level }}","$${HOME}"], (to_string(.message) ?? ""))))
+`))
+		})
+
+		It("should fail when a regular expression is not supported by VRL", func() {
+			spec := []obs.DropTest{
+				{
+					DropConditions: []obs.DropCondition{
+						{
+							Field:   ".message",
+							Matches: `\Q.*\E`,
+						},
+					},
+				},
+			}
+			_, err := NewFilter(spec).VRL()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail when a condition defines more than one operator", func() {
+			spec := []obs.DropTest{
+				{
+					DropConditions: []obs.DropCondition{
+						{
+							Field:   ".level",
+							Matches: "debug",
+							Exists:  utils.GetPtr(true),
+						},
+					},
+				},
+			}
+			_, err := NewFilter(spec).VRL()
+			Expect(err).To(HaveOccurred())
+		})
 	})
 
 })
@@ -0,0 +1,73 @@
+package enrich
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
+)
+
+const defaultTarget = ".enrichment"
+
+type Enrich struct {
+	TableID string
+	Path    string
+	Remap   framework.Element
+}
+
+func (e Enrich) Name() string {
+	return "enrichTemplate"
+}
+
+func (e Enrich) Template() string {
+	return `{{define "` + e.Name() + `" -}}
+[enrichment_tables.{{.TableID}}]
+type = "file"
+file.path = {{.Path}}
+file.encoding.type = "csv"
+
+{{compose_one .Remap}}
+{{end}}`
+}
+
+// NewFactory returns a factory for the enrich transforms of a filter
+func NewFactory(filterName string, spec *obs.EnrichFilterSpec) func(id string, inputs ...string) framework.Element {
+	return func(id string, inputs ...string) framework.Element {
+		return New(id, filterName, spec, inputs...)
+	}
+}
+
+// New returns the lookup table of a filter and a transform that attaches the columns of the table row matching the key
+// of each event
+func New(id, filterName string, spec *obs.EnrichFilterSpec, inputs ...string) framework.Element {
+	tableID := helpers.MakeID(id, "table")
+	return Enrich{
+		TableID: tableID,
+		Path:    TablePath(filterName, *spec),
+		Remap: elements.Remap{
+			ComponentID: id,
+			Inputs:      helpers.MakeInputs(inputs...),
+			VRL:         VRL(tableID, *spec),
+		},
+	}
+}
+
+// VRL returns the VRL that attaches the columns of the table row matching the key of an event
+func VRL(tableID string, spec obs.EnrichFilterSpec) string {
+	target := string(spec.Target)
+	if target == "" {
+		target = defaultTarget
+	}
+	vrl := []string{
+		fmt.Sprintf(`row, err = get_enrichment_table_record(%q, {%q: %s})`, tableID, spec.KeyColumn, commontemplate.TransformUserFilterTemplateToVRL(spec.Key)),
+		"if err == null {",
+	}
+	for _, column := range spec.Columns {
+		vrl = append(vrl, fmt.Sprintf(`  %s.%q = row.%q`, target, column, column))
+	}
+	return strings.Join(append(vrl, "}"), "\n")
+}
@@ -0,0 +1,75 @@
+package enrich
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("enrich filter", func() {
+
+	var spec obs.EnrichFilterSpec
+
+	BeforeEach(func() {
+		spec = obs.EnrichFilterSpec{
+			Table:     obs.ConfigMapReference{Key: "teams.csv", ConfigMapName: "teams"},
+			Key:       `{.kubernetes.namespace_name||"none"}`,
+			KeyColumn: "namespace",
+			Columns:   []string{"team", "cost-center"},
+		}
+	})
+
+	It("should attach the columns of a mounted CSV table", func() {
+		Expect(`
+[enrichment_tables.pipeline_my_enrich_table]
+type = "file"
+file.path = "/var/run/ocp-collector/config/teams/teams.csv"
+file.encoding.type = "csv"
+
+[transforms.pipeline_my_enrich]
+type = "remap"
+inputs = ["pipeline_viaq_0"]
+source = '''
+  row, err = get_enrichment_table_record("pipeline_my_enrich_table", {"namespace": to_string!(.kubernetes.namespace_name||"none")})
+  if err == null {
+    .enrichment."team" = row."team"
+    .enrichment."cost-center" = row."cost-center"
+  }
+'''
+`).To(EqualConfigFrom(New("pipeline_my_enrich", "my-enrich", &spec, "pipeline_viaq_0")))
+	})
+
+	It("should attach the columns of a converted JSON table to the target", func() {
+		spec.Format = obs.EnrichTableFormatJSON
+		spec.Target = ".openshift.owner"
+		spec.Columns = []string{"team"}
+		Expect(`
+[enrichment_tables.pipeline_my_enrich_table]
+type = "file"
+file.path = "/etc/vector/enrich-my-enrich.csv"
+file.encoding.type = "csv"
+
+[transforms.pipeline_my_enrich]
+type = "remap"
+inputs = ["pipeline_viaq_0"]
+source = '''
+  row, err = get_enrichment_table_record("pipeline_my_enrich_table", {"namespace": to_string!(.kubernetes.namespace_name||"none")})
+  if err == null {
+    .openshift.owner."team" = row."team"
+  }
+'''
+`).To(EqualConfigFrom(New("pipeline_my_enrich", "my-enrich", &spec, "pipeline_viaq_0")))
+	})
+	It("should look up the key from the labels of the record", func() {
+		spec.Key = `{.kubernetes.labels.app||"none"}`
+		spec.KeyColumn = "app"
+		spec.Columns = []string{"team"}
+		Expect(VRL("pipeline_my_enrich_table", spec)).To(EqualTrimLines(`
+row, err = get_enrichment_table_record("pipeline_my_enrich_table", {"app": to_string!(.kubernetes.labels.app||"none")})
+if err == null {
+  .enrichment."team" = row."team"
+}
+`))
+	})
+})
@@ -0,0 +1,149 @@
+package enrich
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Table is a lookup table of rows of column values
+type Table struct {
+	Header []string
+	Rows   [][]string
+}
+
+// ParseTable parses the data of a lookup table in the given format. CSV tables have a header line of the column
+// names.  JSON tables are an array of objects of column names to string values
+func ParseTable(format obs.EnrichTableFormat, data string) (*Table, error) {
+	if format == obs.EnrichTableFormatJSON {
+		return parseJSON(data)
+	}
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("table is not valid CSV: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("table must have a header of column names")
+	}
+	return &Table{Header: records[0], Rows: records[1:]}, nil
+}
+
+func parseJSON(data string) (*Table, error) {
+	objects := []map[string]string{}
+	if err := json.Unmarshal([]byte(data), &objects); err != nil {
+		return nil, fmt.Errorf("table must be a JSON array of objects with string values: %v", err)
+	}
+	columns := map[string]bool{}
+	for _, o := range objects {
+		for name := range o {
+			columns[name] = true
+		}
+	}
+	t := &Table{}
+	for name := range columns {
+		t.Header = append(t.Header, name)
+	}
+	sort.Strings(t.Header)
+	for _, o := range objects {
+		row := make([]string, len(t.Header))
+		for i, name := range t.Header {
+			row[i] = o[name]
+		}
+		t.Rows = append(t.Rows, row)
+	}
+	return t, nil
+}
+
+// Validate verifies the table has the key and attached columns of the spec, and the key column values are unique.
+// Empty JSON tables have no columns and are valid
+func (t *Table) Validate(spec obs.EnrichFilterSpec) error {
+	if len(t.Header) == 0 && len(t.Rows) == 0 {
+		return nil
+	}
+	index := map[string]int{}
+	for i, name := range t.Header {
+		index[name] = i
+	}
+	for _, name := range append([]string{spec.KeyColumn}, spec.Columns...) {
+		if _, found := index[name]; !found {
+			return fmt.Errorf("table does not have a %q column", name)
+		}
+	}
+	keys := map[string]bool{}
+	for _, row := range t.Rows {
+		key := row[index[spec.KeyColumn]]
+		if keys[key] {
+			return fmt.Errorf("table has more than one row for key %q", key)
+		}
+		keys[key] = true
+	}
+	return nil
+}
+
+// CSV returns the table formatted as comma separated values with a header line
+func (t *Table) CSV() (string, error) {
+	b := &bytes.Buffer{}
+	w := csv.NewWriter(b)
+	if err := w.WriteAll(append([][]string{t.Header}, t.Rows...)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// StandInTable returns an empty table of the format and columns of the spec
+func StandInTable(spec obs.EnrichFilterSpec) string {
+	if spec.Format == obs.EnrichTableFormatJSON {
+		return "[]"
+	}
+	table := &Table{Header: append([]string{spec.KeyColumn}, spec.Columns...)}
+	data, _ := table.CSV()
+	return data
+}
+
+// TableFile is the name of the file of a JSON table converted to CSV for the collector
+func TableFile(filterName string) string {
+	return fmt.Sprintf("enrich-%s.csv", filterName)
+}
+
+// TablePath is the quoted path of the lookup table of a filter visible to the collector. The collector only reads CSV
+// tables so JSON tables are converted and added to the collector configuration
+func TablePath(filterName string, spec obs.EnrichFilterSpec) string {
+	if spec.Format == obs.EnrichTableFormatJSON {
+		return fmt.Sprintf("%q", filepath.Join(constants.CollectorConfigDir, TableFile(filterName)))
+	}
+	return helpers.ConfigPath(spec.Table.ConfigMapName, spec.Table.Key)
+}
+
+// ConvertedTables returns the JSON tables of the enrich filters converted to CSV, keyed by file name
+func ConvertedTables(filters []obs.FilterSpec, configMaps map[string]*corev1.ConfigMap) (tables map[string]string, err error) {
+	tables = map[string]string{}
+	for _, f := range filters {
+		if f.Type != obs.FilterTypeEnrich || f.EnrichFilterSpec == nil || f.EnrichFilterSpec.Format != obs.EnrichTableFormatJSON {
+			continue
+		}
+		cm, found := configMaps[f.EnrichFilterSpec.Table.ConfigMapName]
+		if !found {
+			return nil, fmt.Errorf("configmap %q for filter %q not found", f.EnrichFilterSpec.Table.ConfigMapName, f.Name)
+		}
+		table, err := ParseTable(obs.EnrichTableFormatJSON, cm.Data[f.EnrichFilterSpec.Table.Key])
+		if err != nil {
+			return nil, fmt.Errorf("filter %q: %v", f.Name, err)
+		}
+		if len(table.Header) == 0 {
+			table.Header = append([]string{f.EnrichFilterSpec.KeyColumn}, f.EnrichFilterSpec.Columns...)
+		}
+		if tables[TableFile(f.Name)], err = table.CSV(); err != nil {
+			return nil, err
+		}
+	}
+	return tables, nil
+}
@@ -0,0 +1,60 @@
+package enrich
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("lookup table", func() {
+
+	var spec = obs.EnrichFilterSpec{
+		Table:     obs.ConfigMapReference{Key: "teams", ConfigMapName: "teams"},
+		Format:    obs.EnrichTableFormatJSON,
+		KeyColumn: "namespace",
+		Columns:   []string{"team"},
+	}
+
+	It("should convert a JSON table to CSV", func() {
+		table, err := ParseTable(obs.EnrichTableFormatJSON, `[{"namespace":"app-a","team":"red"},{"namespace":"app-b","team":"blue","oncall":"bob"}]`)
+		Expect(err).To(BeNil())
+		Expect(table.Validate(spec)).To(Succeed())
+		Expect(table.CSV()).To(Equal("namespace,oncall,team\napp-a,,red\napp-b,bob,blue\n"))
+	})
+
+	DescribeTable("should fail tables of the wrong shape", func(format obs.EnrichTableFormat, data, errMsg string) {
+		table, err := ParseTable(format, data)
+		if err == nil {
+			err = table.Validate(spec)
+		}
+		Expect(err).To(MatchError(ContainSubstring(errMsg)))
+	},
+		Entry("when CSV rows have a different number of columns", obs.EnrichTableFormatCSV, "namespace,team\napp-a\n", "not valid CSV"),
+		Entry("when a CSV table is empty", obs.EnrichTableFormatCSV, "", "must have a header"),
+		Entry("when JSON is not an array of objects", obs.EnrichTableFormatJSON, `{"namespace":"app-a"}`, "JSON array of objects"),
+		Entry("when JSON values are not strings", obs.EnrichTableFormatJSON, `[{"namespace":"app-a","team":1}]`, "JSON array of objects"),
+		Entry("when a column is missing", obs.EnrichTableFormatCSV, "namespace,owner\napp-a,red\n", `does not have a "team" column`),
+		Entry("when a key is not unique", obs.EnrichTableFormatCSV, "namespace,team\napp-a,red\napp-a,blue\n", `more than one row for key "app-a"`),
+	)
+
+	It("should return the converted JSON tables of the enrich filters", func() {
+		filters := []obs.FilterSpec{
+			{Name: "from-json", Type: obs.FilterTypeEnrich, EnrichFilterSpec: &spec},
+			{Name: "from-csv", Type: obs.FilterTypeEnrich, EnrichFilterSpec: &obs.EnrichFilterSpec{
+				Table: obs.ConfigMapReference{Key: "teams.csv", ConfigMapName: "teams"},
+			}},
+		}
+		configMaps := map[string]*corev1.ConfigMap{
+			"teams": runtime.NewConfigMap("openshift-logging", "teams", map[string]string{
+				"teams":     `[{"namespace":"app-a","team":"red"}]`,
+				"teams.csv": "namespace,team\napp-a,red\n",
+			}),
+		}
+		Expect(ConvertedTables(filters, configMaps)).To(Equal(map[string]string{
+			"enrich-from-json.csv": "namespace,team\napp-a,red\n",
+		}))
+	})
+})
@@ -5,12 +5,18 @@ import (
 	log "github.com/ViaQ/logerr/v2/log/static"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/multilineexception"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/mutate"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/parse"
 
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/enrich"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/metrics"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/openshift"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/prune"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/redact"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/sample"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/throttle"
 
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/apiaudit"
 )
@@ -50,10 +56,31 @@ func NewInternalFilterMap(filters map[string]*obs.FilterSpec) map[string]*Intern
 		case obs.FilterTypeKubeApiAudit:
 			internalFilter.RemapFilter = apiaudit.NewFilter(f.KubeApiAudit)
 		case obs.FilterTypeParse:
-			internalFilter.RemapFilter = parse.NewParseFilter()
+			internalFilter.RemapFilter = parse.NewFilter(f.ParseFilterSpec)
+		case obs.FilterTypeMutate:
+			internalFilter.RemapFilter = mutate.NewFilter(f.MutateFilterSpec)
+		case obs.FilterTypeRedact:
+			internalFilter.RemapFilter = redact.NewFilter(f.RedactFilterSpec)
+		case obs.FilterTypeEnrich:
+			internalFilter.SuppliesTransform = true
+			internalFilter.TranformFactory = enrich.NewFactory(f.Name, f.EnrichFilterSpec)
+		case obs.FilterTypeSample:
+			factory, err := sample.NewFactory(f.SampleFilterSpec)
+			if err != nil {
+				log.V(0).Error(err, "bad filter", "spec.type", f.Type, "spec.Name", f.Name)
+				continue
+			}
+			internalFilter.SuppliesTransform = true
+			internalFilter.TranformFactory = factory
+		case obs.FilterTypeThrottle:
+			internalFilter.SuppliesTransform = true
+			internalFilter.TranformFactory = throttle.NewFactory(f.ThrottleFilterSpec)
 		case obs.FilterTypeDetectMultiline:
 			internalFilter.SuppliesTransform = true
-			internalFilter.TranformFactory = multilineexception.NewDetectException
+			internalFilter.TranformFactory = multilineexception.NewFactory(f.DetectMultilineExceptionSpec)
+		case obs.FilterTypeMetrics:
+			internalFilter.SuppliesTransform = true
+			internalFilter.TranformFactory = metrics.NewFactory(f.MetricsFilterSpec)
 		default:
 			log.V(0).Error(fmt.Errorf("unknown filter type: %v", f.Type), "This should have been caught by declarative API validation")
 		}
@@ -0,0 +1,182 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
+)
+
+const (
+	defaultMaxLabelValues = 100
+	metricField           = "._internal.metric"
+)
+
+// Metrics generates a metric from the matching log events and passes the log events through unmodified
+type Metrics struct {
+	Elements []framework.Element
+}
+
+func (m Metrics) Name() string {
+	return "logMetricsTemplate"
+}
+
+func (m Metrics) Template() string {
+	return `{{define "` + m.Name() + `" -}}
+{{compose .Elements}}
+{{end}}`
+}
+
+type LogToMetric struct {
+	ComponentID string
+	Inputs      string
+	Type        obs.LogMetricType
+	MetricName  string
+	Tags        []string
+}
+
+func (l LogToMetric) Name() string {
+	return "logToMetricTemplate"
+}
+
+func (l LogToMetric) Template() string {
+	return `{{define "` + l.Name() + `" -}}
+[transforms.{{.ComponentID}}]
+type = "log_to_metric"
+inputs = {{.Inputs}}
+
+[[transforms.{{.ComponentID}}.metrics]]
+type = "{{.Type}}"
+field = "_internal.metric.value"
+name = "{{.MetricName}}"
+{{- range .Tags}}
+tags.{{.}} = "{{"{{"}} _internal.metric.labels.{{.}} {{"}}"}}"
+{{- end}}
+{{end}}`
+}
+
+type CardinalityLimit struct {
+	ComponentID string
+	Inputs      string
+	ValueLimit  int64
+}
+
+func (c CardinalityLimit) Name() string {
+	return "tagCardinalityLimitTemplate"
+}
+
+func (c CardinalityLimit) Template() string {
+	return `{{define "` + c.Name() + `" -}}
+[transforms.{{.ComponentID}}]
+type = "tag_cardinality_limit"
+inputs = {{.Inputs}}
+mode = "exact"
+value_limit = {{.ValueLimit}}
+limit_exceeded_action = "drop_event"
+{{end}}`
+}
+
+// MetricID is the ID of the component emitting the metric of a metrics filter
+func MetricID(id string) string {
+	return helpers.MakeID(id, "limit")
+}
+
+// NewFactory returns a factory for the transforms of a metrics filter
+func NewFactory(spec *obs.MetricsFilterSpec) func(id string, inputs ...string) framework.Element {
+	return func(id string, inputs ...string) framework.Element {
+		return New(id, spec, inputs...)
+	}
+}
+
+// New returns the transforms that pass through the log events and generate the metric of the spec from the
+// events matching its tests
+func New(id string, spec *obs.MetricsFilterSpec, inputs ...string) framework.Element {
+	vrl, err := VRL(*spec)
+	if err != nil {
+		log.V(0).Error(err, "bad metrics filter", "id", id)
+	}
+	matchID := helpers.MakeID(id, "match")
+	metricID := helpers.MakeID(id, "metric")
+	metricType := spec.Type
+	if metricType == "" {
+		metricType = obs.LogMetricTypeCounter
+	}
+	tags := []string{}
+	for name := range spec.Labels {
+		tags = append(tags, name)
+	}
+	sort.Strings(tags)
+	valueLimit := spec.MaxLabelValues
+	if valueLimit < 1 {
+		valueLimit = defaultMaxLabelValues
+	}
+	return Metrics{
+		Elements: []framework.Element{
+			elements.Remap{
+				ComponentID: id,
+				Inputs:      helpers.MakeInputs(inputs...),
+				VRL:         vrl,
+			},
+			elements.Filter{
+				ComponentID: matchID,
+				Inputs:      helpers.MakeInputs(id),
+				Condition:   fmt.Sprintf("exists(%s)", metricField),
+			},
+			LogToMetric{
+				ComponentID: metricID,
+				Inputs:      helpers.MakeInputs(matchID),
+				Type:        metricType,
+				MetricName:  spec.Name,
+				Tags:        tags,
+			},
+			CardinalityLimit{
+				ComponentID: MetricID(id),
+				Inputs:      helpers.MakeInputs(metricID),
+				ValueLimit:  valueLimit,
+			},
+		},
+	}
+}
+
+// VRL returns the VRL that adds the value and labels of the metric to the matching log events
+func VRL(spec obs.MetricsFilterSpec) (string, error) {
+	names := []string{}
+	for name := range spec.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	labels := []string{}
+	for _, name := range names {
+		labels = append(labels, fmt.Sprintf("%q: %s", name, commontemplate.TransformUserFilterTemplateToVRL(spec.Labels[name])))
+	}
+	metric := []string{fmt.Sprintf(`%s = {"value": 1, "labels": {%s}}`, metricField, strings.Join(labels, ", "))}
+	if spec.Type == obs.LogMetricTypeHistogram {
+		// records without a numeric value are not observed
+		metric = []string{
+			fmt.Sprintf("value = to_float(%s) ?? null", spec.Field),
+			"if value != null {",
+			fmt.Sprintf(`  %s = {"value": value, "labels": {%s}}`, metricField, strings.Join(labels, ", ")),
+			"}",
+		}
+	}
+	vrl := []string{fmt.Sprintf("del(%s)", metricField)}
+	if len(spec.Match) == 0 {
+		return strings.Join(append(vrl, metric...), "\n"), nil
+	}
+	condition, err := drop.Tests(spec.Match)
+	if err != nil {
+		return "", err
+	}
+	vrl = append(vrl, fmt.Sprintf("if %s {", condition))
+	for _, line := range metric {
+		vrl = append(vrl, "  "+line)
+	}
+	return strings.Join(append(vrl, "}"), "\n"), nil
+}
@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("metrics filter", func() {
+
+	It("should count the matching events by label", func() {
+		spec := &obs.MetricsFilterSpec{
+			Name: "error_logs_total",
+			Labels: map[string]string{
+				"namespace": `{.kubernetes.namespace_name||"none"}`,
+				"level":     `{.level||"unknown"}`,
+			},
+			Match: []obs.DropTest{
+				{DropConditions: []obs.DropCondition{{Field: ".level", Matches: "error"}}},
+			},
+		}
+		Expect(`
+[transforms.pipeline_my_metric]
+type = "remap"
+inputs = ["pipeline_viaq_0"]
+source = '''
+  del(._internal.metric)
+  if ((match(to_string(.level) ?? "", r'error'))) {
+    ._internal.metric = {"value": 1, "labels": {"level": to_string!(.level||"unknown"), "namespace": to_string!(.kubernetes.namespace_name||"none")}}
+  }
+'''
+
+[transforms.pipeline_my_metric_match]
+type = "filter"
+inputs = ["pipeline_my_metric"]
+condition = '''
+exists(._internal.metric)
+'''
+
+[transforms.pipeline_my_metric_metric]
+type = "log_to_metric"
+inputs = ["pipeline_my_metric_match"]
+
+[[transforms.pipeline_my_metric_metric.metrics]]
+type = "counter"
+field = "_internal.metric.value"
+name = "error_logs_total"
+tags.level = "{{ _internal.metric.labels.level }}"
+tags.namespace = "{{ _internal.metric.labels.namespace }}"
+
+[transforms.pipeline_my_metric_limit]
+type = "tag_cardinality_limit"
+inputs = ["pipeline_my_metric_metric"]
+mode = "exact"
+value_limit = 100
+limit_exceeded_action = "drop_event"
+`).To(EqualConfigFrom(New("pipeline_my_metric", spec, "pipeline_viaq_0")))
+	})
+
+	It("should observe the numeric field of all events for a histogram", func() {
+		spec := &obs.MetricsFilterSpec{
+			Name:           "request_duration_ms",
+			Type:           obs.LogMetricTypeHistogram,
+			Field:          ".structured.duration_ms",
+			MaxLabelValues: 20,
+		}
+		Expect(`
+[transforms.pipeline_my_metric]
+type = "remap"
+inputs = ["pipeline_viaq_0"]
+source = '''
+  del(._internal.metric)
+  value = to_float(.structured.duration_ms) ?? null
+  if value != null {
+    ._internal.metric = {"value": value, "labels": {}}
+  }
+'''
+
+[transforms.pipeline_my_metric_match]
+type = "filter"
+inputs = ["pipeline_my_metric"]
+condition = '''
+exists(._internal.metric)
+'''
+
+[transforms.pipeline_my_metric_metric]
+type = "log_to_metric"
+inputs = ["pipeline_my_metric_match"]
+
+[[transforms.pipeline_my_metric_metric.metrics]]
+type = "histogram"
+field = "_internal.metric.value"
+name = "request_duration_ms"
+
+[transforms.pipeline_my_metric_limit]
+type = "tag_cardinality_limit"
+inputs = ["pipeline_my_metric_metric"]
+mode = "exact"
+value_limit = 20
+limit_exceeded_action = "drop_event"
+`).To(EqualConfigFrom(New("pipeline_my_metric", spec, "pipeline_viaq_0")))
+	})
+	It("should label the metric with the labels of the record", func() {
+		spec := obs.MetricsFilterSpec{
+			Name:   "logs_total",
+			Labels: map[string]string{"app": `{.kubernetes.labels.app||"none"}`},
+		}
+		Expect(VRL(spec)).To(EqualTrimLines(`
+del(._internal.metric)
+._internal.metric = {"value": 1, "labels": {"app": to_string!(.kubernetes.labels.app||"none")}}
+`))
+	})
+})
@@ -1,20 +1,110 @@
 package multilineexception
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 )
 
-func NewDetectException(id string, inputs ...string) framework.Element {
-	return DetectExceptions{
-		ComponentID: id,
-		Inputs:      helpers.MakeInputs(inputs...),
+const (
+	defaultExpireAfterMs   = 2000
+	defaultFlushIntervalMs = 1000
+)
+
+var (
+	// languages maps the spec'd languages to those of the detect_exceptions transform
+	languages = map[obs.MultilineLanguage]string{
+		obs.MultilineLanguageJava:   "Java",
+		obs.MultilineLanguagePython: "Python",
+		obs.MultilineLanguageGo:     "Go",
+		obs.MultilineLanguageRuby:   "Ruby",
+		obs.MultilineLanguageJS:     "Js",
+		obs.MultilineLanguagePHP:    "Php",
+		obs.MultilineLanguageDart:   "Dart",
+	}
+
+	// DotNetRule detects .NET exceptions which are not supported by the detect_exceptions transform
+	DotNetRule = obs.MultilineRule{
+		StartPattern:        `^(Unhandled exception\. )?[a-zA-Z_][\w.]*(Exception|Error)\b`,
+		ContinuationPattern: `^(\s+at |\s+--- End of |\s*---> )`,
+	}
+)
+
+// NewFactory returns a factory for the multi-line detection transforms of the spec
+func NewFactory(spec *obs.DetectMultilineExceptionFilterSpec) func(id string, inputs ...string) framework.Element {
+	return func(id string, inputs ...string) framework.Element {
+		return NewDetectException(id, spec, inputs...)
 	}
 }
 
+// NewDetectException returns the transforms that reassemble exception stack traces and the custom multi-line rules
+// of the spec. Custom rules are applied before exceptions are detected
+func NewDetectException(id string, spec *obs.DetectMultilineExceptionFilterSpec, inputs ...string) framework.Element {
+	if spec == nil {
+		spec = &obs.DetectMultilineExceptionFilterSpec{}
+	}
+	expireAfterMs, flushIntervalMs := int64(defaultExpireAfterMs), int64(defaultFlushIntervalMs)
+	if spec.ExpireAfterMilliseconds > 0 {
+		expireAfterMs = spec.ExpireAfterMilliseconds
+	}
+	if spec.FlushIntervalMilliseconds > 0 {
+		flushIntervalMs = spec.FlushIntervalMilliseconds
+	}
+
+	langs, rules := detectLanguages(spec.Languages)
+	rules = append(rules, spec.Rules...)
+
+	d := DetectExceptions{
+		ComponentID:     id,
+		Languages:       langs,
+		ExpireAfterMs:   expireAfterMs,
+		FlushIntervalMs: flushIntervalMs,
+	}
+	for i, rule := range rules {
+		ruleID := helpers.MakeID(id, "rule", strconv.Itoa(i))
+		if len(langs) == 0 && i == len(rules)-1 {
+			ruleID = id
+		}
+		r, err := NewRule(ruleID, rule, expireAfterMs, flushIntervalMs, inputs...)
+		if err != nil {
+			log.V(0).Error(err, "bad multiline rule", "id", id, "rule", i)
+			continue
+		}
+		d.Rules = append(d.Rules, r)
+		inputs = []string{ruleID}
+	}
+	d.Inputs = helpers.MakeInputs(inputs...)
+	return d
+}
+
+// detectLanguages returns the detect_exceptions languages and the rules for the languages it does not support
+func detectLanguages(specLangs []obs.MultilineLanguage) (langs []string, rules []obs.MultilineRule) {
+	if len(specLangs) == 0 {
+		return []string{"All"}, nil
+	}
+	for _, l := range specLangs {
+		if l == obs.MultilineLanguageDotNet {
+			rules = append(rules, DotNetRule)
+		} else if name, found := languages[l]; found {
+			langs = append(langs, name)
+		}
+	}
+	return langs, rules
+}
+
 type DetectExceptions struct {
-	ComponentID string
-	Inputs      string
+	ComponentID     string
+	Inputs          string
+	Languages       []string
+	ExpireAfterMs   int64
+	FlushIntervalMs int64
+	Rules           []framework.Element
 }
 
 func (d DetectExceptions) Name() string {
@@ -23,12 +113,94 @@ func (d DetectExceptions) Name() string {
 
 func (d DetectExceptions) Template() string {
 	return `{{define "detectExceptions" -}}
+{{range .Rules -}}
+{{compose_one .}}
+
+{{end -}}
+{{if .Languages -}}
 [transforms.{{.ComponentID}}]
 type = "detect_exceptions"
 inputs = {{.Inputs}}
-languages = ["All"]
+languages = [{{range $i, $l := .Languages}}{{if $i}},{{end}}"{{$l}}"{{end}}]
 group_by = ["kubernetes.namespace_name","kubernetes.pod_name","kubernetes.container_name", "kubernetes.pod_id"]
-expire_after_ms = 2000
-multiline_flush_interval_ms = 1000
+expire_after_ms = {{.ExpireAfterMs}}
+multiline_flush_interval_ms = {{.FlushIntervalMs}}
+{{end -}}
+{{end}}`
+}
+
+// discardFields are the top-level fields of a record whose values are taken from the first line of a multi-line record
+// instead of the default merge strategies of the reduce transform (e.g. summing numbers)
+var discardFields = []string{
+	`"@timestamp"`,
+	"_internal",
+	"file",
+	"hostname",
+	"kubernetes",
+	"level",
+	"log_source",
+	"log_type",
+	"openshift",
+	"source_type",
+	"stream",
+	"structured",
+	"timestamp",
+}
+
+// Rule reassembles the lines of a custom multi-line format from the same container
+type Rule struct {
+	ComponentID     string
+	Inputs          string
+	StartsWhen      string
+	ExpireAfterMs   int64
+	FlushIntervalMs int64
+	DiscardFields   []string
+}
+
+func (r Rule) Name() string {
+	return "multilineRule"
+}
+
+func (r Rule) Template() string {
+	return `{{define "multilineRule" -}}
+[transforms.{{.ComponentID}}]
+type = "reduce"
+inputs = {{.Inputs}}
+group_by = ["kubernetes.namespace_name","kubernetes.pod_name","kubernetes.container_name","kubernetes.pod_id"]
+expire_after_ms = {{.ExpireAfterMs}}
+flush_period_ms = {{.FlushIntervalMs}}
+merge_strategies.message = "concat_newline"
+{{- range .DiscardFields}}
+merge_strategies.{{.}} = "discard"
+{{- end}}
+starts_when = '''
+{{.StartsWhen}}
+'''
 {{end}}`
 }
+
+// NewRule returns a transform that starts a new record for each line matching the start pattern of the rule, or
+// not matching its continuation pattern, and appends all other lines to the message of the record. All other fields
+// of the record are those of its first line
+func NewRule(id string, rule obs.MultilineRule, expireAfterMs, flushIntervalMs int64, inputs ...string) (framework.Element, error) {
+	start, err := drop.Condition(obs.DropCondition{Field: ".message", Matches: rule.StartPattern})
+	if err != nil {
+		return nil, fmt.Errorf("startPattern: %v", err)
+	}
+	conditions := []string{start}
+	if rule.ContinuationPattern != "" {
+		continuation, err := drop.Condition(obs.DropCondition{Field: ".message", NotMatches: rule.ContinuationPattern})
+		if err != nil {
+			return nil, fmt.Errorf("continuationPattern: %v", err)
+		}
+		conditions = append(conditions, continuation)
+	}
+	return Rule{
+		ComponentID:     id,
+		Inputs:          helpers.MakeInputs(inputs...),
+		StartsWhen:      strings.Join(conditions, " || "),
+		ExpireAfterMs:   expireAfterMs,
+		FlushIntervalMs: flushIntervalMs,
+		DiscardFields:   discardFields,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package multilineexception
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("detectMultilineException filter", func() {
+
+	It("should detect exceptions of all languages when no spec is defined", func() {
+		Expect(`
+[transforms.pipeline_my_detect]
+type = "detect_exceptions"
+inputs = ["pipeline_viaq_0"]
+languages = ["All"]
+group_by = ["kubernetes.namespace_name","kubernetes.pod_name","kubernetes.container_name", "kubernetes.pod_id"]
+expire_after_ms = 2000
+multiline_flush_interval_ms = 1000
+`).To(EqualConfigFrom(NewDetectException("pipeline_my_detect", nil, "pipeline_viaq_0")))
+	})
+
+	It("should detect exceptions of the spec'd languages with the spec'd timeouts", func() {
+		spec := &obs.DetectMultilineExceptionFilterSpec{
+			Languages:                 []obs.MultilineLanguage{obs.MultilineLanguageJava, obs.MultilineLanguagePython},
+			ExpireAfterMilliseconds:   5000,
+			FlushIntervalMilliseconds: 500,
+		}
+		Expect(`
+[transforms.pipeline_my_detect]
+type = "detect_exceptions"
+inputs = ["pipeline_viaq_0"]
+languages = ["Java","Python"]
+group_by = ["kubernetes.namespace_name","kubernetes.pod_name","kubernetes.container_name", "kubernetes.pod_id"]
+expire_after_ms = 5000
+multiline_flush_interval_ms = 500
+`).To(EqualConfigFrom(NewDetectException("pipeline_my_detect", spec, "pipeline_viaq_0")))
+	})
+
+	It("should apply custom rules before detecting exceptions", func() {
+		spec := &obs.DetectMultilineExceptionFilterSpec{
+			Languages: []obs.MultilineLanguage{obs.MultilineLanguageGo},
+			Rules: []obs.MultilineRule{
+				{StartPattern: `^(SELECT|INSERT|UPDATE|DELETE)\b`, ContinuationPattern: `^\s+`},
+			},
+		}
+		Expect(`
+[transforms.pipeline_my_detect_rule_0]
+type = "reduce"
+inputs = ["pipeline_viaq_0"]
+group_by = ["kubernetes.namespace_name","kubernetes.pod_name","kubernetes.container_name","kubernetes.pod_id"]
+expire_after_ms = 2000
+flush_period_ms = 1000
+merge_strategies.message = "concat_newline"
+merge_strategies."@timestamp" = "discard"
+merge_strategies._internal = "discard"
+merge_strategies.file = "discard"
+merge_strategies.hostname = "discard"
+merge_strategies.kubernetes = "discard"
+merge_strategies.level = "discard"
+merge_strategies.log_source = "discard"
+merge_strategies.log_type = "discard"
+merge_strategies.openshift = "discard"
+merge_strategies.source_type = "discard"
+merge_strategies.stream = "discard"
+merge_strategies.structured = "discard"
+merge_strategies.timestamp = "discard"
+starts_when = '''
+match(to_string(.message) ?? "", r'^(SELECT|INSERT|UPDATE|DELETE)\b') || !match(to_string(.message) ?? "", r'^\s+')
+'''
+
+[transforms.pipeline_my_detect]
+type = "detect_exceptions"
+inputs = ["pipeline_my_detect_rule_0"]
+languages = ["Go"]
+group_by = ["kubernetes.namespace_name","kubernetes.pod_name","kubernetes.container_name", "kubernetes.pod_id"]
+expire_after_ms = 2000
+multiline_flush_interval_ms = 1000
+`).To(EqualConfigFrom(NewDetectException("pipeline_my_detect", spec, "pipeline_viaq_0")))
+	})
+
+	It("should detect .NET exceptions with a rule when it is the only language", func() {
+		spec := &obs.DetectMultilineExceptionFilterSpec{
+			Languages: []obs.MultilineLanguage{obs.MultilineLanguageDotNet},
+		}
+		Expect(`
+[transforms.pipeline_my_detect]
+type = "reduce"
+inputs = ["pipeline_viaq_0"]
+group_by = ["kubernetes.namespace_name","kubernetes.pod_name","kubernetes.container_name","kubernetes.pod_id"]
+expire_after_ms = 2000
+flush_period_ms = 1000
+merge_strategies.message = "concat_newline"
+merge_strategies."@timestamp" = "discard"
+merge_strategies._internal = "discard"
+merge_strategies.file = "discard"
+merge_strategies.hostname = "discard"
+merge_strategies.kubernetes = "discard"
+merge_strategies.level = "discard"
+merge_strategies.log_source = "discard"
+merge_strategies.log_type = "discard"
+merge_strategies.openshift = "discard"
+merge_strategies.source_type = "discard"
+merge_strategies.stream = "discard"
+merge_strategies.structured = "discard"
+merge_strategies.timestamp = "discard"
+starts_when = '''
+match(to_string(.message) ?? "", r'^(Unhandled exception\. )?[a-zA-Z_][\w.]*(Exception|Error)\b') || !match(to_string(.message) ?? "", r'^(\s+at |\s+--- End of |\s*---> )')
+'''
+`).To(EqualConfigFrom(NewDetectException("pipeline_my_detect", spec, "pipeline_viaq_0")))
+	})
+})
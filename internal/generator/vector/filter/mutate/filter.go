@@ -0,0 +1,67 @@
+package mutate
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
+)
+
+type Filter struct {
+	operations []obs.MutateOperation
+}
+
+// NewFilter returns a mutate filter that applies the operations in order
+func NewFilter(operations []obs.MutateOperation) Filter {
+	return Filter{operations: operations}
+}
+
+func (f Filter) VRL() (string, error) {
+	vrl := []string{}
+	for i, op := range f.operations {
+		opVRL, err := Operation(op)
+		if err != nil {
+			return "", fmt.Errorf("operation[%d] %v", i, err)
+		}
+		vrl = append(vrl, opVRL)
+	}
+	return strings.Join(vrl, "\n"), nil
+}
+
+// Operation returns the VRL for a single mutate operation
+func Operation(op obs.MutateOperation) (string, error) {
+	field := op.Field
+	switch op.Type {
+	case obs.MutateOperationRename:
+		if op.Target == "" {
+			return "", fmt.Errorf("%s operation requires a target", op.Type)
+		}
+		return fmt.Sprintf("if exists(%s) {\n  %s = del(%s)\n}", field, op.Target, field), nil
+	case obs.MutateOperationCopy:
+		if op.Target == "" {
+			return "", fmt.Errorf("%s operation requires a target", op.Type)
+		}
+		return fmt.Sprintf("if exists(%s) {\n  %s = %s\n}", field, op.Target, field), nil
+	case obs.MutateOperationSet:
+		return fmt.Sprintf("%s = %s", field, commontemplate.TransformUserFilterTemplateToVRL(op.Value)), nil
+	case obs.MutateOperationDelete:
+		return fmt.Sprintf("del(%s)", field), nil
+	case obs.MutateOperationLowercase:
+		return fmt.Sprintf("if is_string(%s) {\n  %s = downcase(string!(%s))\n}", field, field, field), nil
+	case obs.MutateOperationUppercase:
+		return fmt.Sprintf("if is_string(%s) {\n  %s = upcase(string!(%s))\n}", field, field, field), nil
+	case obs.MutateOperationCoerce:
+		switch op.CoerceType {
+		case obs.MutateCoerceTypeInt:
+			return fmt.Sprintf("if exists(%s) {\n  %s = to_int(%s) ?? %s\n}", field, field, field, field), nil
+		case obs.MutateCoerceTypeBool:
+			return fmt.Sprintf("if exists(%s) {\n  %s = to_bool(%s) ?? %s\n}", field, field, field, field), nil
+		case obs.MutateCoerceTypeTimestamp:
+			return fmt.Sprintf("if is_string(%s) {\n  %s = parse_timestamp(string!(%s), \"%%+\") ?? %s\n} else if is_integer(%s) {\n  %s = from_unix_timestamp!(int!(%s))\n}",
+				field, field, field, field, field, field, field), nil
+		}
+		return "", fmt.Errorf("unknown coerce type: %q", op.CoerceType)
+	}
+	return "", fmt.Errorf("unknown operation type: %q", op.Type)
+}
@@ -0,0 +1,84 @@
+package mutate
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("mutate filter", func() {
+
+	It("should apply the operations in order", func() {
+		spec := []obs.MutateOperation{
+			{Type: obs.MutateOperationRename, Field: ".kubernetes.labels.app", Target: `.service.name`},
+			{Type: obs.MutateOperationSet, Field: ".environment", Value: "production"},
+			{Type: obs.MutateOperationDelete, Field: ".kubernetes.annotations"},
+		}
+		Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if exists(.kubernetes.labels.app) {
+  .service.name = del(.kubernetes.labels.app)
+}
+.environment = "production"
+del(.kubernetes.annotations)
+`))
+	})
+
+	DescribeTable("#Operation", func(op obs.MutateOperation, exp string) {
+		Expect(Operation(op)).To(matchers.EqualTrimLines(exp))
+	},
+		Entry("should copy the field to the target",
+			obs.MutateOperation{Type: obs.MutateOperationCopy, Field: ".kubernetes.namespace_name", Target: `.kubernetes."copy-of-namespace"`},
+			`
+if exists(.kubernetes.namespace_name) {
+  .kubernetes."copy-of-namespace" = .kubernetes.namespace_name
+}
+`),
+		Entry("should set the field from a template",
+			obs.MutateOperation{Type: obs.MutateOperationSet, Field: ".service.name", Value: `app-{.kubernetes.labels.app||"none"}`},
+			`.service.name = "app-" + to_string!(.kubernetes.labels.app||"none")`),
+		Entry("should lowercase string fields",
+			obs.MutateOperation{Type: obs.MutateOperationLowercase, Field: ".level"},
+			`
+if is_string(.level) {
+  .level = downcase(string!(.level))
+}
+`),
+		Entry("should uppercase string fields",
+			obs.MutateOperation{Type: obs.MutateOperationUppercase, Field: ".level"},
+			`
+if is_string(.level) {
+  .level = upcase(string!(.level))
+}
+`),
+		Entry("should coerce the field to an integer",
+			obs.MutateOperation{Type: obs.MutateOperationCoerce, Field: ".status", CoerceType: obs.MutateCoerceTypeInt},
+			`
+if exists(.status) {
+  .status = to_int(.status) ?? .status
+}
+`),
+		Entry("should coerce the field to a boolean",
+			obs.MutateOperation{Type: obs.MutateOperationCoerce, Field: ".cached", CoerceType: obs.MutateCoerceTypeBool},
+			`
+if exists(.cached) {
+  .cached = to_bool(.cached) ?? .cached
+}
+`),
+		Entry("should coerce the field to a timestamp",
+			obs.MutateOperation{Type: obs.MutateOperationCoerce, Field: ".time", CoerceType: obs.MutateCoerceTypeTimestamp},
+			`
+if is_string(.time) {
+  .time = parse_timestamp(string!(.time), "%+") ?? .time
+} else if is_integer(.time) {
+  .time = from_unix_timestamp!(int!(.time))
+}
+`),
+	)
+
+	It("should fail when a rename operation does not spec a target", func() {
+		_, err := NewFilter([]obs.MutateOperation{{Type: obs.MutateOperationRename, Field: ".level"}}).VRL()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -105,7 +105,7 @@ func HasJournalSource(inputs []obs.InputSpec) bool {
 
 func hasContainerSource(inputSpecs []obs.InputSpec) bool {
 	for _, i := range inputSpecs {
-		if i.Type == obs.InputTypeApplication {
+		if i.Type == obs.InputTypeApplication || i.Type == obs.InputTypeEvents {
 			return true
 		}
 		if i.Type == obs.InputTypeInfrastructure && i.Infrastructure != nil && (len(i.Infrastructure.Sources) == 0 || set.New(i.Infrastructure.Sources...).Has(obs.InfrastructureSourceContainer)) {
@@ -0,0 +1,124 @@
+package parse
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"text/template"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+const (
+	DefaultSource obs.FieldPath = ".message"
+	DefaultTarget obs.FieldPath = ".structured"
+
+	defaultKeyValueDelimiter = "="
+	defaultFieldDelimiter    = " "
+	defaultCSVDelimiter      = ","
+
+	// parseJSON is the VRL used when a parse filter does not spec a parser
+	parseJSON = `
+	if .log_source == "container" {
+		parsed, err = parse_json(.message)
+		if err == null {
+			.structured = parsed
+			del(.message)
+		}
+	}
+	`
+)
+
+var (
+	//go:embed parse.vrl.tmpl
+	parseVRLTemplateStr string
+	ParseVRLTemplate    = template.Must(template.New("parse VRL").Parse(parseVRLTemplateStr))
+)
+
+type Parse struct {
+	Source       obs.FieldPath
+	Target       obs.FieldPath
+	KeepOriginal bool
+	Parser       string
+	Headers      string
+}
+
+type Filter struct {
+	spec *obs.ParseFilterSpec
+}
+
+// NewFilter returns a parse filter for the spec.  A nil spec parses container messages as JSON
+func NewFilter(spec *obs.ParseFilterSpec) Filter {
+	return Filter{spec: spec}
+}
+
+func (f Filter) VRL() (string, error) {
+	if f.spec == nil {
+		return parseJSON, nil
+	}
+	parse := Parse{
+		Source:       f.spec.Source,
+		Target:       f.spec.Target,
+		KeepOriginal: f.spec.KeepOriginal,
+	}
+	if parse.Source == "" {
+		parse.Source = DefaultSource
+	}
+	if parse.Target == "" {
+		parse.Target = DefaultTarget
+	}
+	value := fmt.Sprintf(`to_string(%s) ?? ""`, parse.Source)
+	switch f.spec.Type {
+	case obs.ParserTypeJSON:
+		parse.Parser = fmt.Sprintf("parse_json(%s)", value)
+	case obs.ParserTypeLogfmt:
+		parse.Parser = fmt.Sprintf("parse_logfmt(%s)", value)
+	case obs.ParserTypeKeyValue:
+		kvDelimiter, fieldDelimiter := defaultKeyValueDelimiter, defaultFieldDelimiter
+		if f.spec.KeyValue != nil {
+			if f.spec.KeyValue.KeyValueDelimiter != "" {
+				kvDelimiter = f.spec.KeyValue.KeyValueDelimiter
+			}
+			if f.spec.KeyValue.FieldDelimiter != "" {
+				fieldDelimiter = f.spec.KeyValue.FieldDelimiter
+			}
+		}
+		parse.Parser = fmt.Sprintf("parse_key_value(%s, key_value_delimiter: %q, field_delimiter: %q)", value, kvDelimiter, fieldDelimiter)
+	case obs.ParserTypeRegex:
+		if f.spec.Regex == nil {
+			return "", fmt.Errorf("regex parser requires a pattern")
+		}
+		parse.Parser = fmt.Sprintf("parse_regex(%s, r'%s')", value, f.spec.Regex.Pattern)
+	case obs.ParserTypeCSV:
+		if f.spec.CSV == nil {
+			return "", fmt.Errorf("csv parser requires headers")
+		}
+		delimiter := defaultCSVDelimiter
+		if f.spec.CSV.Delimiter != "" {
+			delimiter = f.spec.CSV.Delimiter
+		}
+		parse.Parser = fmt.Sprintf("parse_csv(%s, delimiter: %q)", value, delimiter)
+		headers := []string{}
+		for _, h := range f.spec.CSV.Headers {
+			headers = append(headers, fmt.Sprintf("%q", h))
+		}
+		parse.Headers = fmt.Sprintf("[%s]", strings.Join(headers, ","))
+	case obs.ParserTypeGrok:
+		if f.spec.Grok == nil {
+			return "", fmt.Errorf("grok parser requires a pattern")
+		}
+		parse.Parser = fmt.Sprintf("parse_grok(%s, %q)", value, f.spec.Grok.Pattern)
+	case obs.ParserTypeApacheCommon:
+		parse.Parser = fmt.Sprintf(`parse_apache_log(%s, format: "common")`, value)
+	case obs.ParserTypeApacheCombined:
+		parse.Parser = fmt.Sprintf(`parse_apache_log(%s, format: "combined")`, value)
+	case obs.ParserTypeNginxCombined:
+		parse.Parser = fmt.Sprintf(`parse_nginx_log(%s, format: "combined")`, value)
+	default:
+		return "", fmt.Errorf("unknown parser type: %q", f.spec.Type)
+	}
+
+	w := &strings.Builder{}
+	err := ParseVRLTemplate.Execute(w, parse)
+	return w.String(), err
+}
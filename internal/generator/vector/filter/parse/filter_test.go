@@ -0,0 +1,137 @@
+package parse
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("parse filter", func() {
+
+	It("should parse container messages as JSON when no parser is spec'd", func() {
+		Expect(NewFilter(nil).VRL()).To(matchers.EqualTrimLines(`
+if .log_source == "container" {
+  parsed, err = parse_json(.message)
+  if err == null {
+    .structured = parsed
+    del(.message)
+  }
+}
+`))
+	})
+
+	DescribeTable("#VRL", func(spec obs.ParseFilterSpec, exp string) {
+		Expect(NewFilter(&spec).VRL()).To(matchers.EqualTrimLines(exp))
+	},
+		Entry("should default the source and target for the json parser",
+			obs.ParseFilterSpec{Type: obs.ParserTypeJSON},
+			`
+if exists(.message) {
+  parsed, err = parse_json(to_string(.message) ?? "")
+  if err == null {
+    del(.message)
+    .structured = parsed
+  }
+}
+`),
+		Entry("should retain the source field when keepOriginal is true",
+			obs.ParseFilterSpec{Type: obs.ParserTypeLogfmt, Source: ".payload", Target: `.kubernetes."parsed-payload"`, KeepOriginal: true},
+			`
+if exists(.payload) {
+  parsed, err = parse_logfmt(to_string(.payload) ?? "")
+  if err == null {
+    .kubernetes."parsed-payload" = parsed
+  }
+}
+`),
+		Entry("should default the delimiters for the keyValue parser",
+			obs.ParseFilterSpec{Type: obs.ParserTypeKeyValue},
+			`
+if exists(.message) {
+  parsed, err = parse_key_value(to_string(.message) ?? "", key_value_delimiter: "=", field_delimiter: " ")
+  if err == null {
+    del(.message)
+    .structured = parsed
+  }
+}
+`),
+		Entry("should use the spec'd delimiters for the keyValue parser",
+			obs.ParseFilterSpec{Type: obs.ParserTypeKeyValue, KeyValue: &obs.KeyValueParserSpec{KeyValueDelimiter: ":", FieldDelimiter: ","}},
+			`
+if exists(.message) {
+  parsed, err = parse_key_value(to_string(.message) ?? "", key_value_delimiter: ":", field_delimiter: ",")
+  if err == null {
+    del(.message)
+    .structured = parsed
+  }
+}
+`),
+		Entry("should use the pattern for the regex parser",
+			obs.ParseFilterSpec{Type: obs.ParserTypeRegex, Regex: &obs.RegexParserSpec{Pattern: `^(?P<level>\w+): (?P<msg>.*)$`}},
+			`
+if exists(.message) {
+  parsed, err = parse_regex(to_string(.message) ?? "", r'^(?P<level>\w+): (?P<msg>.*)$')
+  if err == null {
+    del(.message)
+    .structured = parsed
+  }
+}
+`),
+		Entry("should map values to headers for the csv parser",
+			obs.ParseFilterSpec{Type: obs.ParserTypeCSV, CSV: &obs.CSVParserSpec{Headers: []string{"time", "level", "msg"}, Delimiter: ";"}},
+			`
+if exists(.message) {
+  parsed, err = parse_csv(to_string(.message) ?? "", delimiter: ";")
+  if err == null {
+    values = parsed
+    parsed = {}
+    for_each(["time","level","msg"]) -> |index, header| {
+      parsed = set!(parsed, [header], get(values, [index]) ?? null)
+    }
+    del(.message)
+    .structured = parsed
+  }
+}
+`),
+		Entry("should quote the pattern for the grok parser",
+			obs.ParseFilterSpec{Type: obs.ParserTypeGrok, Grok: &obs.GrokParserSpec{Pattern: `%{LOGLEVEL:level} "%{GREEDYDATA:msg}"`}},
+			`
+if exists(.message) {
+  parsed, err = parse_grok(to_string(.message) ?? "", "%{LOGLEVEL:level} \"%{GREEDYDATA:msg}\"")
+  if err == null {
+    del(.message)
+    .structured = parsed
+  }
+}
+`),
+		Entry("should use the common format for the apacheCommon parser",
+			obs.ParseFilterSpec{Type: obs.ParserTypeApacheCommon},
+			`
+if exists(.message) {
+  parsed, err = parse_apache_log(to_string(.message) ?? "", format: "common")
+  if err == null {
+    del(.message)
+    .structured = parsed
+  }
+}
+`),
+		Entry("should use the combined format for the nginxCombined parser",
+			obs.ParseFilterSpec{Type: obs.ParserTypeNginxCombined},
+			`
+if exists(.message) {
+  parsed, err = parse_nginx_log(to_string(.message) ?? "", format: "combined")
+  if err == null {
+    del(.message)
+    .structured = parsed
+  }
+}
+`),
+	)
+
+	It("should fail for a parser missing its required spec", func() {
+		_, err := NewFilter(&obs.ParseFilterSpec{Type: obs.ParserTypeRegex}).VRL()
+		Expect(err).To(HaveOccurred())
+	})
+})
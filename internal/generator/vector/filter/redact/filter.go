@@ -0,0 +1,127 @@
+package redact
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	DefaultField obs.FieldPath = ".message"
+	DefaultText                = "[REDACTED]"
+)
+
+var (
+	//go:embed redact.vrl.tmpl
+	redactVRLTemplateStr string
+	RedactVRLTemplate    = template.Must(template.New("redact VRL").Parse(redactVRLTemplateStr))
+
+	// Detectors are the regular expressions of the built-in detectors
+	Detectors = map[obs.RedactDetector]string{
+		obs.RedactDetectorEmail:        `[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}`,
+		obs.RedactDetectorIPv4:         `\b(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\b`,
+		obs.RedactDetectorIPv6:         `(?i)\b(?:[0-9a-f]{1,4}:){7}[0-9a-f]{1,4}\b|\b(?:[0-9a-f]{1,4}:){1,7}:(?:[0-9a-f]{1,4}(?::[0-9a-f]{1,4}){0,6})?`,
+		obs.RedactDetectorCreditCard:   `\b(?:[0-9][ -]?){12,18}[0-9]\b`,
+		obs.RedactDetectorJWT:          `\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`,
+		obs.RedactDetectorBearerToken:  `(?i)\bbearer\s+[A-Za-z0-9\-._~+/]+=*`,
+		obs.RedactDetectorAWSAccessKey: `\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`,
+	}
+)
+
+type Pattern struct {
+	Pattern string
+	// Luhn replaces only the matches that pass the Luhn checksum
+	Luhn bool
+}
+
+type Redact struct {
+	Fields      []obs.FieldPath
+	Patterns    []Pattern
+	Replacement string
+}
+
+type Filter struct {
+	spec *obs.RedactFilterSpec
+}
+
+// NewFilter returns a redact filter
+func NewFilter(spec *obs.RedactFilterSpec) Filter {
+	return Filter{spec: spec}
+}
+
+func (f Filter) VRL() (string, error) {
+	if f.spec == nil {
+		return "", fmt.Errorf("redact filter requires a spec")
+	}
+	redact := Redact{
+		Fields:      f.spec.Fields,
+		Replacement: Replacement(f.spec.Replacement),
+	}
+	if len(redact.Fields) == 0 {
+		redact.Fields = []obs.FieldPath{DefaultField}
+	}
+	for _, d := range f.spec.Detectors {
+		pattern, found := Detectors[d]
+		if !found {
+			return "", fmt.Errorf("unknown detector: %q", d)
+		}
+		redact.Patterns = append(redact.Patterns, Pattern{Pattern: pattern, Luhn: d == obs.RedactDetectorCreditCard})
+	}
+	for _, p := range f.spec.Patterns {
+		if err := ValidatePattern(p); err != nil {
+			return "", err
+		}
+		redact.Patterns = append(redact.Patterns, Pattern{Pattern: p})
+	}
+
+	w := &strings.Builder{}
+	err := RedactVRLTemplate.Execute(w, redact)
+	return w.String(), err
+}
+
+// ValidatePattern verifies a user supplied pattern is a valid regular expression that can be embedded in VRL
+func ValidatePattern(pattern string) error {
+	if strings.Contains(pattern, "'") {
+		return fmt.Errorf("pattern %q can not contain a single quote (')", pattern)
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("pattern %q must be a valid regular expression", pattern)
+	}
+	return nil
+}
+
+// ValidateSalt verifies the value of a salt can be substituted by the collector into the VRL string literal of the
+// salt, which is not escaped
+func ValidateSalt(salt string) error {
+	if strings.ContainsAny(salt, "\"\\{}\n\r") {
+		return errors.New("salt can not contain a double quote, backslash, brace or line break")
+	}
+	return nil
+}
+
+// Replacement returns the VRL expression that replaces a matched value
+func Replacement(r *obs.RedactReplacement) string {
+	if r == nil {
+		return `replace(match.string, r'.', "*")`
+	}
+	switch r.Type {
+	case obs.RedactReplacementText:
+		text := r.Text
+		if text == "" {
+			text = DefaultText
+		}
+		return vectorhelpers.VRLString(text)
+	case obs.RedactReplacementHash:
+		if salt := vectorhelpers.SecretFrom(r.Salt); salt != "" {
+			return fmt.Sprintf(`sha2(%s + match.string, variant: "SHA-256")`, vectorhelpers.VRLString(salt))
+		}
+		return `sha2(match.string, variant: "SHA-256")`
+	}
+	return `replace(match.string, r'.', "*")`
+}
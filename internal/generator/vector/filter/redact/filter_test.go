@@ -0,0 +1,96 @@
+package redact
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("redact filter", func() {
+
+	It("should mask the message when only detectors are spec'd", func() {
+		spec := &obs.RedactFilterSpec{Detectors: []obs.RedactDetector{obs.RedactDetectorAWSAccessKey}}
+		Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if is_string(.message) {
+  value = string!(.message)
+  value = replace_with(value, r'\b(?:AKIA|ASIA)[0-9A-Z]{16}\b') -> |match| {
+    replace(match.string, r'.', "*")
+  }
+  .message = value
+}
+`))
+	})
+
+	It("should hash the spec'd fields with a salt and only replace credit card numbers that pass the Luhn checksum", func() {
+		spec := &obs.RedactFilterSpec{
+			Detectors: []obs.RedactDetector{obs.RedactDetectorCreditCard},
+			Fields:    []obs.FieldPath{".structured.payment"},
+			Replacement: &obs.RedactReplacement{
+				Type: obs.RedactReplacementHash,
+				Salt: &obs.SecretReference{Key: "salt", SecretName: "redact"},
+			},
+		}
+		Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if is_string(.structured.payment) {
+  value = string!(.structured.payment)
+  value = replace_with(value, r'\b(?:[0-9][ -]?){12,18}[0-9]\b') -> |match| {
+    digits = filter(split(replace(match.string, r'[^0-9]', ""), "")) -> |_index, digit| { digit != "" }
+    parity = length(digits) % 2
+    sum = 0
+    for_each(digits) -> |index, digit| {
+      d = to_int(digit) ?? 0
+      if index % 2 == parity {
+        d = d * 2
+        if d > 9 {
+          d = d - 9
+        }
+      }
+      sum = sum + d
+    }
+    if sum % 10 == 0 {
+      sha2("SECRET[kubernetes_secret.redact/salt]" + match.string, variant: "SHA-256")
+    } else {
+      match.string
+    }
+  }
+  .structured.payment = value
+}
+`))
+	})
+
+	It("should replace custom patterns with fixed text after the detectors", func() {
+		spec := &obs.RedactFilterSpec{
+			Detectors:   []obs.RedactDetector{obs.RedactDetectorEmail},
+			Patterns:    []string{`ssn=\d{3}-\d{2}-\d{4}`},
+			Replacement: &obs.RedactReplacement{Type: obs.RedactReplacementText, Text: "<pii>"},
+		}
+		Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if is_string(.message) {
+  value = string!(.message)
+  value = replace_with(value, r'[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}') -> |match| {
+    "<pii>"
+  }
+  value = replace_with(value, r'ssn=\d{3}-\d{2}-\d{4}') -> |match| {
+    "<pii>"
+  }
+  .message = value
+}
+`))
+	})
+
+	DescribeTable("#Replacement", func(replacement *obs.RedactReplacement, exp string) {
+		Expect(Replacement(replacement)).To(Equal(exp))
+	},
+		Entry("should mask by default", nil, `replace(match.string, r'.', "*")`),
+		Entry("should default the text", &obs.RedactReplacement{Type: obs.RedactReplacementText}, `"[REDACTED]"`),
+		Entry("should escape the text", &obs.RedactReplacement{Type: obs.RedactReplacementText, Text: `"{x}"`}, `"\"{x}\""`),
+		Entry("should hash without a salt", &obs.RedactReplacement{Type: obs.RedactReplacementHash}, `sha2(match.string, variant: "SHA-256")`),
+	)
+
+	It("should fail for patterns that are not valid regular expressions", func() {
+		_, err := NewFilter(&obs.RedactFilterSpec{Patterns: []string{"["}}).VRL()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,85 @@
+package sample
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+type Sample struct {
+	ComponentID string
+	Inputs      string
+	Rate        int64
+	KeyField    framework.Element
+	Exclude     string
+}
+
+func (s Sample) Name() string {
+	return "sampleTemplate"
+}
+
+func (s Sample) Template() string {
+	return `{{define "` + s.Name() + `" -}}
+[transforms.{{.ComponentID}}]
+type = "sample"
+inputs = {{.Inputs}}
+rate = {{.Rate}}
+{{kv .KeyField -}}
+{{- if .Exclude}}
+exclude = '''
+{{.Exclude}}
+'''
+{{- end}}
+{{end}}`
+}
+
+// NewFactory returns a factory for sample transforms of the spec or an error when the exclusion of the spec is invalid
+func NewFactory(spec *obs.SampleFilterSpec) (func(id string, inputs ...string) framework.Element, error) {
+	exclude, err := exclusion(spec)
+	if err != nil {
+		return nil, err
+	}
+	return func(id string, inputs ...string) framework.Element {
+		return newSample(id, spec, exclude, inputs...)
+	}, nil
+}
+
+// New returns a sample transform that forwards one out of every `rate` events that are not excluded
+func New(id string, spec *obs.SampleFilterSpec, inputs ...string) (framework.Element, error) {
+	exclude, err := exclusion(spec)
+	if err != nil {
+		return nil, err
+	}
+	return newSample(id, spec, exclude, inputs...), nil
+}
+
+func newSample(id string, spec *obs.SampleFilterSpec, exclude string, inputs ...string) Sample {
+	s := Sample{
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		Rate:        spec.Rate,
+		KeyField:    framework.Nil,
+		Exclude:     exclude,
+	}
+	if spec.KeyField != "" {
+		s.KeyField = elements.KV("key_field", fmt.Sprintf("%q", strings.TrimPrefix(string(spec.KeyField), ".")))
+	}
+	return s
+}
+
+// exclusion returns the VRL condition of the events that are never sampled away
+func exclusion(spec *obs.SampleFilterSpec) (string, error) {
+	if len(spec.Exclude) == 0 {
+		return "", nil
+	}
+	exclude, err := drop.Tests(spec.Exclude)
+	if err != nil {
+		return "", fmt.Errorf("bad sample filter exclusion: %v", err)
+	}
+	return exclude, nil
+}
@@ -0,0 +1,76 @@
+package sample
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("sample filter", func() {
+
+	newElement := func(id string, spec *obs.SampleFilterSpec, inputs ...string) framework.Element {
+		el, err := New(id, spec, inputs...)
+		Expect(err).ToNot(HaveOccurred())
+		return el
+	}
+
+	It("should sample events independently when no key field is spec'd", func() {
+		spec := &obs.SampleFilterSpec{Rate: 10}
+		Expect(`
+[transforms.pipeline_my_sample]
+type = "sample"
+inputs = ["pipeline_viaq_0"]
+rate = 10
+`).To(EqualConfigFrom(newElement("pipeline_my_sample", spec, "pipeline_viaq_0")))
+	})
+
+	It("should sample events by key and never sample away excluded events", func() {
+		spec := &obs.SampleFilterSpec{
+			Rate:     4,
+			KeyField: ".kubernetes.pod_name",
+			Exclude: []obs.DropTest{
+				{
+					DropConditions: []obs.DropCondition{
+						{Field: ".level", In: []string{"error", "critical"}},
+					},
+				},
+				{
+					DropConditions: []obs.DropCondition{
+						{Field: ".status", GreaterThan: utils.GetPtr(resource.MustParse("499"))},
+					},
+				},
+			},
+		}
+		Expect(`
+[transforms.pipeline_my_sample]
+type = "sample"
+inputs = ["pipeline_viaq_0"]
+rate = 4
+key_field = "kubernetes.pod_name"
+exclude = '''
+((includes(["error","critical"], (to_string(.level) ?? ""))) || ((!is_null(.status) && (to_float(.status) ?? 499) > 499)))
+'''
+`).To(EqualConfigFrom(newElement("pipeline_my_sample", spec, "pipeline_viaq_0")))
+	})
+
+	It("should return an error when an exclusion is invalid", func() {
+		spec := &obs.SampleFilterSpec{
+			Rate: 4,
+			Exclude: []obs.DropTest{
+				{
+					DropConditions: []obs.DropCondition{
+						{Field: ".level", Equals: utils.GetPtr("error"), NotMatches: "debug"},
+					},
+				},
+			},
+		}
+		_, err := New("pipeline_my_sample", spec, "pipeline_viaq_0")
+		Expect(err).To(HaveOccurred())
+		_, err = NewFactory(spec)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,128 @@
+package throttle
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
+)
+
+const (
+	keyField     = "throttle_key"
+	keyFieldPath = "{{ _internal." + keyField + " }}"
+)
+
+type Throttle struct {
+	ComponentID string
+	Inputs      string
+	KeyRemap    framework.Element
+	KeyField    string
+	Threshold   int64
+	WindowSecs  int64
+}
+
+func (t Throttle) Name() string {
+	return "throttleFilterTemplate"
+}
+
+func (t Throttle) Template() string {
+	return `{{define "` + t.Name() + `" -}}
+{{- if .KeyRemap}}{{compose_one .KeyRemap}}
+
+{{end -}}
+[transforms.{{.ComponentID}}]
+type = "throttle"
+inputs = {{.Inputs}}
+window_secs = {{.WindowSecs}}
+threshold = {{.Threshold}}
+{{- if .KeyField}}
+key_field = "{{.KeyField}}"
+{{- end}}
+{{end}}`
+}
+
+// Tag counts the events per key and window, and marks the events exceeding the threshold instead of dropping them.
+// It is a lua transform because the throttle transform has no output for the events it drops
+type Tag struct {
+	Throttle
+}
+
+func (t Tag) Name() string {
+	return "throttleTagFilterTemplate"
+}
+
+func (t Tag) Template() string {
+	return `{{define "` + t.Name() + `" -}}
+{{- if .KeyRemap}}{{compose_one .KeyRemap}}
+
+{{end -}}
+[transforms.{{.ComponentID}}]
+type = "lua"
+inputs = {{.Inputs}}
+version = "2"
+hooks.init = "init"
+hooks.process = "process"
+source = '''
+  function init()
+    counts = {}
+    window_start = os.time()
+  end
+
+  function process(event, emit)
+    local now = os.time()
+    if now - window_start >= {{.WindowSecs}} then
+      counts = {}
+      window_start = now
+    end
+    local key = ""
+{{- if .KeyField}}
+    if event.log._internal ~= nil and event.log._internal.{{.KeyField}} ~= nil then
+      key = event.log._internal.{{.KeyField}}
+    end
+{{- end}}
+    counts[key] = (counts[key] or 0) + 1
+    if counts[key] > {{.Threshold}} then
+      event.log.throttled = true
+    end
+    emit(event)
+  end
+'''
+{{end}}`
+}
+
+// NewFactory returns a factory for throttle transforms of the spec
+func NewFactory(spec *obs.ThrottleFilterSpec) func(id string, inputs ...string) framework.Element {
+	return func(id string, inputs ...string) framework.Element {
+		return New(id, spec, inputs...)
+	}
+}
+
+// New returns a transform that limits the rate of events per key to the threshold of the spec. Events exceeding the
+// threshold are dropped or tagged depending upon the action of the spec
+func New(id string, spec *obs.ThrottleFilterSpec, inputs ...string) framework.Element {
+	windowSecs := spec.WindowSeconds
+	if windowSecs < 1 {
+		windowSecs = 1
+	}
+	t := Throttle{
+		ComponentID: id,
+		Threshold:   spec.Threshold,
+		WindowSecs:  windowSecs,
+	}
+	if spec.Key != "" {
+		keyID := helpers.MakeID(id, "key")
+		t.KeyRemap = commontemplate.FilterTemplateRemap(keyID, inputs, spec.Key, keyField, "Throttle Key")
+		inputs = []string{keyID}
+	}
+	t.Inputs = helpers.MakeInputs(inputs...)
+	if spec.Action == obs.ThrottleActionTag {
+		if t.KeyRemap != nil {
+			t.KeyField = keyField
+		}
+		return Tag{Throttle: t}
+	}
+	if t.KeyRemap != nil {
+		t.KeyField = keyFieldPath
+	}
+	return t
+}
@@ -0,0 +1,94 @@
+package throttle
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("throttle filter", func() {
+
+	It("should throttle all events together when no key is spec'd", func() {
+		spec := &obs.ThrottleFilterSpec{Threshold: 100}
+		Expect(`
+[transforms.pipeline_my_throttle]
+type = "throttle"
+inputs = ["pipeline_viaq_0"]
+window_secs = 1
+threshold = 100
+`).To(EqualConfigFrom(New("pipeline_my_throttle", spec, "pipeline_viaq_0")))
+	})
+
+	It("should drop events exceeding the threshold per key", func() {
+		spec := &obs.ThrottleFilterSpec{
+			Key:           `{.kubernetes.namespace_name||"none"}`,
+			Threshold:     500,
+			WindowSeconds: 10,
+			Action:        obs.ThrottleActionDrop,
+		}
+		Expect(`
+# Throttle Key
+[transforms.pipeline_my_throttle_key]
+type = "remap"
+inputs = ["pipeline_viaq_0"]
+source = '''
+  ._internal.throttle_key = to_string!(.kubernetes.namespace_name||"none")
+'''
+
+[transforms.pipeline_my_throttle]
+type = "throttle"
+inputs = ["pipeline_my_throttle_key"]
+window_secs = 10
+threshold = 500
+key_field = "{{ _internal.throttle_key }}"
+`).To(EqualConfigFrom(New("pipeline_my_throttle", spec, "pipeline_viaq_0")))
+	})
+
+	It("should tag events exceeding the threshold per key", func() {
+		spec := &obs.ThrottleFilterSpec{
+			Key:       `{.kubernetes.labels.tenant||"none"}`,
+			Threshold: 50,
+			Action:    obs.ThrottleActionTag,
+		}
+		Expect(`
+# Throttle Key
+[transforms.pipeline_my_throttle_key]
+type = "remap"
+inputs = ["pipeline_viaq_0"]
+source = '''
+  ._internal.throttle_key = to_string!(.kubernetes.labels.tenant||"none")
+'''
+
+[transforms.pipeline_my_throttle]
+type = "lua"
+inputs = ["pipeline_my_throttle_key"]
+version = "2"
+hooks.init = "init"
+hooks.process = "process"
+source = '''
+  function init()
+    counts = {}
+    window_start = os.time()
+  end
+
+  function process(event, emit)
+    local now = os.time()
+    if now - window_start >= 1 then
+      counts = {}
+      window_start = now
+    end
+    local key = ""
+    if event.log._internal ~= nil and event.log._internal.throttle_key ~= nil then
+      key = event.log._internal.throttle_key
+    end
+    counts[key] = (counts[key] or 0) + 1
+    if counts[key] > 50 then
+      event.log.throttled = true
+    end
+    emit(event)
+  end
+'''
+`).To(EqualConfigFrom(New("pipeline_my_throttle", spec, "pipeline_viaq_0")))
+	})
+})
@@ -80,3 +80,18 @@ func SecretFrom(secretKey *v1.SecretReference) string {
 	}
 	return ""
 }
+
+var vrlStringEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"$", "$$",
+)
+
+// VRLString is the quoted VRL string literal of a value. Dollar signs are escaped so values are never interpolated as
+// environment variables when the collector loads its configuration
+func VRLString(value string) string {
+	return `"` + vrlStringEscaper.Replace(value) + `"`
+}
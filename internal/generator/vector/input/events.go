@@ -0,0 +1,42 @@
+package input
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/source"
+)
+
+const eventWatcherContainerPathFmt = "%s_%s-*/%s/*.log"
+
+// NewEventsSource reads the events written by the container of the input in the event watcher deployment of the
+// forwarder. The watcher writes events to stdout and its own logs to stderr
+func NewEventsSource(input obs.InputSpec, collectorNS string, resNames factory.ForwarderResourceNames) ([]Element, []string) {
+	id := helpers.MakeInputID(input.Name, "events")
+	stdoutID := helpers.MakeID(id, "stdout")
+	metaID := helpers.MakeID(id, "meta")
+	includes := source.NewContainerPathGlobBuilder().
+		AddOther(fmt.Sprintf(eventWatcherContainerPathFmt, collectorNS, resNames.EventWatcher, input.Name)).
+		Build()
+	excludes := source.NewContainerPathGlobBuilder().AddExtensions(excludeExtensions...).Build()
+	el := []Element{
+		source.KubernetesLogs{
+			ComponentID:  id,
+			Desc:         "Kubernetes events from the event watcher",
+			IncludePaths: includes,
+			ExcludePaths: excludes,
+		},
+		elements.Filter{
+			Desc:        "Events written by the event watcher",
+			ComponentID: stdoutID,
+			Inputs:      helpers.MakeInputs(id),
+			Condition:   `.stream == "stdout"`,
+		},
+		NewLogSourceAndType(metaID, obs.InfrastructureSourceContainer, obs.InputTypeInfrastructure, stdoutID),
+	}
+	return el, []string{metaID}
+}
@@ -7,10 +7,10 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 )
 
-func NewLogSourceAndType(id string, logSource, logType interface{}, inputs string) framework.Element {
+func NewLogSourceAndType(id string, logSource, logType interface{}, inputs ...string) framework.Element {
 	return elements.Remap{
 		ComponentID: id,
-		Inputs:      helpers.MakeInputs(inputs),
+		Inputs:      helpers.MakeInputs(inputs...),
 		VRL:         fmt.Sprintf(".log_source = %q\n.log_type = %q", logSource, logType),
 	}
 }
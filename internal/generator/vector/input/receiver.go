@@ -12,20 +12,43 @@ import (
 
 func NewViaqReceiverSource(spec obs.InputSpec, resNames factory.ForwarderResourceNames, secrets observability.Secrets, op generator.Options) ([]generator.Element, []string) {
 	base := helpers.MakeInputID(spec.Name)
-	tlsConfig := receiverTLS(base, spec.Receiver.TLS, secrets, op)
+	tlsConfig := receiverTLS(base, *spec.Receiver, secrets, op)
 
 	var els []generator.Element
 	metaID := helpers.MakeID(base, "meta")
 
 	switch spec.Receiver.Type {
 	case obs.ReceiverTypeSyslog:
-		els = append(els,
-			source.NewSyslogSource(base, resNames.GenerateInputServiceName(spec.Name), spec),
-			tlsConfig,
-			NewLogSourceAndType(metaID, obs.InfrastructureSourceNode, obs.InputTypeInfrastructure, base),
-		)
+		serviceName := resNames.GenerateInputServiceName(spec.Name)
+		var ids []string
+		protocol := observability.SyslogReceiverProtocol(*spec.Receiver)
+		if protocol != obs.SyslogReceiverProtocolUDP {
+			els = append(els, source.NewSyslogSource(base, serviceName, spec), tlsConfig)
+			ids = append(ids, base)
+		}
+		if protocol != obs.SyslogReceiverProtocolTCP {
+			udpID := helpers.MakeID(base, "udp")
+			els = append(els, source.NewUDPSyslogSource(udpID, serviceName, spec))
+			ids = append(ids, udpID)
+		}
+		if spec.Receiver.Syslog != nil && spec.Receiver.Syslog.RFC != "" {
+			filter, filterID := source.NewSyslogRFCFilter(base, ids, spec.Receiver.Syslog.RFC)
+			els = append(els, filter)
+			ids = []string{filterID}
+		}
+		els = append(els, NewLogSourceAndType(metaID, obs.InfrastructureSourceNode, obs.InputTypeInfrastructure, ids...))
 	case obs.ReceiverTypeHTTP:
 		el, id := source.NewHttpSource(base, resNames.GenerateInputServiceName(spec.Name), spec)
+		if spec.Receiver.HTTP.Format != obs.HTTPReceiverFormatKubeApiAudit {
+			mapping, mappingID := source.NewFieldMappingTransform(base, id, *spec.Receiver.HTTP)
+			els = append(els,
+				el,
+				tlsConfig,
+				mapping,
+				NewLogSourceAndType(metaID, obs.ApplicationSourceContainer, obs.InputTypeApplication, mappingID),
+			)
+			break
+		}
 		split, splitID := source.NewSplitTransform(base, id)
 		items, itemsID := source.NewItemsTransform(base, splitID)
 		els = append(els,
@@ -35,11 +58,21 @@ func NewViaqReceiverSource(spec obs.InputSpec, resNames factory.ForwarderResourc
 			items,
 			NewLogSourceAndType(metaID, obs.AuditSourceKube, obs.InputTypeAudit, itemsID),
 		)
+	case obs.ReceiverTypeOTLP:
+		sources, logsID := source.NewOTLPSource(base, resNames.GenerateInputServiceName(spec.Name), spec,
+			receiverTLS(helpers.MakeRouteInputID(base, "grpc"), *spec.Receiver, secrets, op),
+			receiverTLS(helpers.MakeRouteInputID(base, "http"), *spec.Receiver, secrets, op),
+		)
+		viaq, viaqID := source.NewOTLPViaqTransform(base, logsID)
+		els = append(els, sources...)
+		els = append(els, viaq)
+		return els, []string{viaqID}
 	}
 	return els, []string{metaID}
 }
 
-func receiverTLS(id string, spec *obs.InputTLSSpec, secrets observability.Secrets, op generator.Options) generator.Element {
+func receiverTLS(id string, receiver obs.ReceiverSpec, secrets observability.Secrets, op generator.Options) generator.Element {
+	spec := observability.ReceiverTLS(receiver)
 	if spec == nil {
 		return generator.Nil
 	}
@@ -51,6 +84,12 @@ func receiverTLS(id string, spec *obs.InputTLSSpec, secrets observability.Secret
 			KeyPassphrase: spec.KeyPassphrase,
 		},
 	}
-	template := tls.New(id, tlsSpec, secrets, op, generator.Option{Name: tls.Component, Value: "sources"}, generator.Option{Name: tls.IncludeEnabled, Value: ""})
-	return template
+	options := []generator.Option{
+		{Name: tls.Component, Value: "sources"},
+		{Name: tls.IncludeEnabled, Value: ""},
+	}
+	if receiver.Syslog != nil && receiver.Syslog.ClientCA != nil {
+		options = append(options, tls.VerifyCertificateOption)
+	}
+	return tls.New(id, tlsSpec, secrets, op, options...)
 }
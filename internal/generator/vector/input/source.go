@@ -7,12 +7,15 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/source"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/set"
 	"regexp"
+	"slices"
 )
 
 const (
@@ -22,8 +25,16 @@ const (
 
 var (
 	//// TODO: Remove ES/Kibana from excludes
-	loggingExcludes = source.NewContainerPathGlobBuilder().
-			AddOther(
+	loggingExcludes   = newLoggingExcludes()
+	excludeExtensions = []string{"gz", "tmp", "log.*"}
+	infraNamespaces   = []string{"default", "openshift*", "kube*"}
+	infraNSRegex      = regexp.MustCompile(`^(?P<default>default)|(?P<openshift>openshift.*)|(?P<kube>kube.*)$`)
+)
+
+// newLoggingExcludes returns the paths of the logging components that are never collected and the additional paths
+func newLoggingExcludes(other ...string) string {
+	return source.NewContainerPathGlobBuilder().
+		AddOther(
 			fmt.Sprintf(nsPodPathFmt, constants.OpenshiftNS, constants.LogfilesmetricexporterName),
 			fmt.Sprintf(nsPodPathFmt, constants.OpenshiftNS, constants.ElasticsearchName),
 			fmt.Sprintf(nsPodPathFmt, constants.OpenshiftNS, constants.KibanaName),
@@ -31,18 +42,19 @@ var (
 		fmt.Sprintf(nsContainerPathFmt, constants.OpenshiftNS, "loki*"),
 		fmt.Sprintf(nsContainerPathFmt, constants.OpenshiftNS, "gateway"),
 		fmt.Sprintf(nsContainerPathFmt, constants.OpenshiftNS, "opa"),
-	).AddExtensions(excludeExtensions...).
+	).AddOther(other...).
+		AddExtensions(excludeExtensions...).
 		Build()
-	excludeExtensions = []string{"gz", "tmp", "log.*"}
-	infraNamespaces   = []string{"default", "openshift*", "kube*"}
-	infraNSRegex      = regexp.MustCompile(`^(?P<default>default)|(?P<openshift>openshift.*)|(?P<kube>kube.*)$`)
-)
+}
 
 // NewSource creates an input adapter to generate config for ViaQ sources to collect logs excluding the
 // collector container logs from the namespace where the collector is deployed
 func NewSource(input obs.InputSpec, collectorNS string, resNames factory.ForwarderResourceNames, secrets internalobs.Secrets, op framework.Options) ([]framework.Element, []string) {
 	els := []framework.Element{}
 	ids := []string{}
+	extraInfraNamespaces, _ := utils.GetOption(op, framework.OptionInfrastructureNamespaces, []string{})
+	// the logs of the event watcher are only read by events inputs
+	eventWatcher, hasEventWatcher := utils.GetOption(op, framework.OptionEventWatcher, "")
 	switch input.Type {
 	case obs.InputTypeApplication:
 		ib := source.NewContainerPathGlobBuilder()
@@ -60,7 +72,7 @@ func NewSource(input obs.InputSpec, collectorNS string, resNames factory.Forward
 				}
 			}
 			// Need to remove any of the default excluded infra namespaces if they are part of the includes
-			excludesList := pruneInfraNS(appIncludes)
+			excludesList := pruneInfraNS(appIncludes, extraInfraNamespaces)
 			for _, ns := range excludesList {
 				ncs := source.NamespaceContainer{
 					Namespace: ns,
@@ -78,7 +90,7 @@ func NewSource(input obs.InputSpec, collectorNS string, resNames factory.Forward
 			}
 		} else {
 			// Need to remove any of the default excluded infra namespaces if they are part of the includes
-			excludesList := pruneInfraNS(appIncludes)
+			excludesList := pruneInfraNS(appIncludes, extraInfraNamespaces)
 			for _, ns := range excludesList {
 				ncs := source.NamespaceContainer{
 					Namespace: ns,
@@ -86,9 +98,12 @@ func NewSource(input obs.InputSpec, collectorNS string, resNames factory.Forward
 				eb.AddCombined(ncs)
 			}
 		}
+		if hasEventWatcher {
+			eb.AddOther(fmt.Sprintf(nsPodPathFmt, collectorNS, eventWatcher))
+		}
 		eb.AddExtensions(excludeExtensions...)
 		includes := ib.Build()
-		excludes := eb.Build(infraNamespaces...)
+		excludes := eb.Build(append(append([]string{}, infraNamespaces...), extraInfraNamespaces...)...)
 		return NewContainerSource(input, collectorNS, includes, excludes, obs.InputTypeApplication, obs.InfrastructureSourceContainer)
 	case obs.InputTypeInfrastructure:
 		sources := set.Set[obs.InfrastructureSource]{}
@@ -101,8 +116,12 @@ func NewSource(input obs.InputSpec, collectorNS string, resNames factory.Forward
 			}
 		}
 		if sources.Has(obs.InfrastructureSourceContainer) {
-			infraIncludes := source.NewContainerPathGlobBuilder().AddNamespaces(infraNamespaces...).Build()
-			cels, cids := NewContainerSource(input, collectorNS, infraIncludes, loggingExcludes, obs.InputTypeInfrastructure, obs.InfrastructureSourceContainer)
+			infraIncludes := source.NewContainerPathGlobBuilder().AddNamespaces(append(append([]string{}, infraNamespaces...), extraInfraNamespaces...)...).Build()
+			infraExcludes := loggingExcludes
+			if hasEventWatcher {
+				infraExcludes = newLoggingExcludes(fmt.Sprintf(nsPodPathFmt, collectorNS, eventWatcher))
+			}
+			cels, cids := NewContainerSource(input, collectorNS, infraIncludes, infraExcludes, obs.InputTypeInfrastructure, obs.InfrastructureSourceContainer)
 			els = append(els, cels...)
 			ids = append(ids, cids...)
 		}
@@ -145,6 +164,8 @@ func NewSource(input obs.InputSpec, collectorNS string, resNames factory.Forward
 		return els, ids
 	case obs.InputTypeReceiver:
 		return NewViaqReceiverSource(input, resNames, secrets, op)
+	case obs.InputTypeEvents:
+		return NewEventsSource(input, collectorNS, resNames)
 	}
 	return els, ids
 }
@@ -152,9 +173,10 @@ func NewSource(input obs.InputSpec, collectorNS string, resNames factory.Forward
 // NewContainerSource generates config elements and the id reference of this input and normalizes
 func NewContainerSource(spec obs.InputSpec, namespace, includes, excludes string, logType obs.InputType, logSource interface{}) ([]framework.Element, []string) {
 	base := helpers.MakeInputID(spec.Name, "container")
-	var selector *metav1.LabelSelector
+	var selector, namespaceSelector *metav1.LabelSelector
 	if spec.Application != nil {
 		selector = spec.Application.Selector
+		namespaceSelector = spec.Application.NamespaceSelector
 	}
 	metaID := helpers.MakeID(base, "meta")
 	el := []framework.Element{
@@ -165,8 +187,19 @@ func NewContainerSource(spec obs.InputSpec, namespace, includes, excludes string
 			ExcludePaths:       excludes,
 			ExtraLabelSelector: source.LabelSelectorFrom(selector),
 		},
-		NewLogSourceAndType(metaID, logSource, logType, base),
 	}
+	metaInput := base
+	// the source only selects pods by their labels so namespaces are selected by the labels attached to the events
+	if condition := source.NamespaceLabelCondition(namespaceSelector); condition != "" {
+		metaInput = helpers.MakeID(base, "namespace_selector")
+		el = append(el, elements.Filter{
+			Desc:        "Logs from namespaces with matching labels",
+			ComponentID: metaInput,
+			Inputs:      helpers.MakeInputs(base),
+			Condition:   condition,
+		})
+	}
+	el = append(el, NewLogSourceAndType(metaID, logSource, logType, metaInput))
 	inputID := metaID
 	//TODO: DETERMINE IF key field is correct and actually works
 	if threshold, hasPolicy := internalobs.MaxRecordsPerSecond(spec); hasPolicy {
@@ -184,7 +217,9 @@ func NewContainerSource(spec obs.InputSpec, namespace, includes, excludes string
 // Include: ["openshift-logging"]
 // Default Exclude: ["default", "openshift*", "kube*"]
 // Final infra namespaces in Exclude: ["default", "kube*"]
-func pruneInfraNS(includes []string) []string {
+//
+// Additional infra namespaces are removed when an include matches their glob
+func pruneInfraNS(includes, extraInfraNamespaces []string) []string {
 	foundInfraNamespaces := make(map[string]string)
 	for _, ns := range includes {
 		matches := infraNSRegex.FindStringSubmatch(ns)
@@ -198,6 +233,11 @@ func pruneInfraNS(includes []string) []string {
 	}
 
 	infraNSSet := sets.NewString(infraNamespaces...)
+	for _, pattern := range extraInfraNamespaces {
+		if !slices.ContainsFunc(includes, func(ns string) bool { return utils.MatchesAnyGlob(ns, pattern) }) {
+			infraNSSet.Insert(pattern)
+		}
+	}
 	// Remove infra namespace depending on the named capture group
 	for k := range foundInfraNamespaces {
 		switch k {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/onsi/ginkgo"
@@ -12,6 +13,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
 )
 
@@ -240,6 +242,22 @@ var _ = Describe("inputs", func() {
 		},
 			"application_with_matchLabels.toml",
 		),
+		Entry("with an application that specs a namespace selector", obs.InputSpec{
+			Name: "my-app",
+			Type: obs.InputTypeApplication,
+			Application: &obs.Application{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"tenant": "acme",
+					},
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "environment", Operator: metav1.LabelSelectorOpIn, Values: []string{"production", "qa"}},
+					},
+				},
+			},
+		},
+			"application_with_namespace_selector.toml",
+		),
 		Entry("with an infrastructure input should generate a container and journal source", obs.InputSpec{
 			Name: string(obs.InputTypeInfrastructure),
 			Type: obs.InputTypeInfrastructure,
@@ -343,6 +361,50 @@ var _ = Describe("inputs", func() {
 		},
 			"receiver_http_audit.toml",
 		),
+		Entry("with an http ndjson receiver input should generate an http receiver with field mappings", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatNDJSON,
+					FieldMappings: &obs.HTTPReceiverFieldMappings{
+						Message:   ".msg",
+						Timestamp: ".time",
+						Level:     `."log-level"`,
+					},
+				},
+			},
+		},
+			"receiver_http_ndjson.toml",
+		),
+		Entry("with an http json receiver input should generate an http receiver with the record as structured", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatJSON,
+				},
+			},
+		},
+			"receiver_http_json.toml",
+		),
+		Entry("with an http raw receiver input should generate an http receiver of text lines", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatRaw,
+				},
+			},
+		},
+			"receiver_http_raw.toml",
+		),
 		Entry("with a syslog receiver input should generate VIAQ syslog receiver", obs.InputSpec{
 			Type: obs.InputTypeReceiver,
 			Name: "myreceiver",
@@ -371,6 +433,49 @@ var _ = Describe("inputs", func() {
 		},
 			"receiver_syslog.toml",
 		),
+		Entry("with a syslog receiver over tcp and udp with client certificate verification", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeSyslog,
+				Port: 12345,
+				Syslog: &obs.SyslogReceiver{
+					Protocol:       obs.SyslogReceiverProtocolBoth,
+					MaxMessageSize: utils.GetPtr(resource.MustParse("64Ki")),
+					RFC:            obs.SyslogRFC5424,
+					ClientCA: &obs.ValueReference{
+						Key:           "ca.crt",
+						ConfigMapName: "client-ca",
+					},
+				},
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+			},
+		},
+			"receiver_syslog_tcp_udp_mtls.toml",
+		),
+		Entry("with a syslog receiver over udp", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeSyslog,
+				Port: 12345,
+				Syslog: &obs.SyslogReceiver{
+					Protocol: obs.SyslogReceiverProtocolUDP,
+					RFC:      obs.SyslogRFC3164,
+				},
+			},
+		},
+			"receiver_syslog_udp.toml",
+		),
 		Entry("with a syslog receiver and tls from configmaps", obs.InputSpec{
 			Type: obs.InputTypeReceiver,
 			Name: "myreceiver",
@@ -395,5 +500,115 @@ var _ = Describe("inputs", func() {
 		},
 			"receiver_syslog_tls_from_configmap.toml",
 		),
+		Entry("with an OTLP receiver input should generate an OTLP receiver mapped to VIAQ", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeOTLP,
+				Port: 4318,
+				OTLP: &obs.OTLPReceiver{
+					GRPCPort: 14317,
+				},
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+			},
+		},
+			"receiver_otlp.toml",
+		),
+	)
+
+	DescribeTable("#NewSource with additional infrastructure namespaces", func(input obs.InputSpec, expFile string) {
+		exp, err := tomlContent.ReadFile(expFile)
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", expFile, err))
+		}
+		clf := obs.ClusterLogForwarder{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      constants.SingletonName,
+				Namespace: constants.OpenshiftNS,
+			},
+		}
+		op := framework.Options{
+			framework.OptionInfrastructureNamespaces: []string{"istio-system", "platform-*"},
+		}
+		conf, _ := NewSource(input, constants.OpenshiftNS, *factory.ResourceNames(clf), secrets, op)
+		Expect(string(exp)).To(EqualConfigFrom(conf))
+	},
+		Entry("with an application input should exclude the infrastructure namespaces", obs.InputSpec{
+			Name: string(obs.InputTypeApplication),
+			Type: obs.InputTypeApplication,
+		},
+			"application_with_extra_infra_namespaces.toml",
+		),
+		Entry("with an application input should not exclude included infrastructure namespaces", obs.InputSpec{
+			Name: string(obs.InputTypeApplication),
+			Type: obs.InputTypeApplication,
+			Application: &obs.Application{
+				Includes: []obs.NamespaceContainerSpec{
+					{Namespace: "platform-auth"},
+				},
+			},
+		},
+			"application_includes_extra_infra_namespace.toml",
+		),
+		Entry("with an infrastructure input should include the infrastructure namespaces", obs.InputSpec{
+			Name: "myinfra",
+			Type: obs.InputTypeInfrastructure,
+			Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceContainer},
+			},
+		},
+			"infrastructure_container_with_extra_namespaces.toml",
+		),
+	)
+
+	DescribeTable("#NewSource with an event watcher", func(input obs.InputSpec, expFile string) {
+		exp, err := tomlContent.ReadFile(expFile)
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", expFile, err))
+		}
+		clf := obs.ClusterLogForwarder{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      constants.SingletonName,
+				Namespace: constants.OpenshiftNS,
+			},
+		}
+		resNames := factory.ResourceNames(clf)
+		op := framework.Options{
+			framework.OptionEventWatcher: resNames.EventWatcher,
+		}
+		conf, _ := NewSource(input, constants.OpenshiftNS, *resNames, secrets, op)
+		Expect(string(exp)).To(EqualConfigFrom(conf))
+	},
+		Entry("with an events input should read the events of its event watcher container", obs.InputSpec{
+			Name:   "myevents",
+			Type:   obs.InputTypeEvents,
+			Events: &obs.Events{},
+		},
+			"events.toml",
+		),
+		Entry("with an application input should exclude the event watcher", obs.InputSpec{
+			Name: string(obs.InputTypeApplication),
+			Type: obs.InputTypeApplication,
+		},
+			"application_excludes_event_watcher.toml",
+		),
+		Entry("with an infrastructure input should exclude the event watcher", obs.InputSpec{
+			Name: "myinfra",
+			Type: obs.InputTypeInfrastructure,
+			Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceContainer},
+			},
+		},
+			"infrastructure_container_excludes_event_watcher.toml",
+		),
 	)
 })
@@ -30,7 +30,10 @@ func (o *Output) Elements() []generator.Element {
 	if o == nil {
 		return []generator.Element{}
 	}
-	el := New(o.spec, o.inputIDs, o.secrets, o, o.op)
+	el, dropped := o.elements()
+	if len(dropped) > 0 {
+		el = append(el, NewTransformFailure(o.spec.Name, dropped))
+	}
 	return el
 }
 
@@ -27,13 +27,22 @@ type Template struct {
 }
 
 func TemplateRemap(componentID string, inputs []string, userTemplate, field, description string) framework.Element {
+	return templateRemap(componentID, inputs, TransformUserTemplateToVRL(userTemplate), field, description)
+}
+
+// FilterTemplateRemap is the TemplateRemap of the user entered template of a filter
+func FilterTemplateRemap(componentID string, inputs []string, userTemplate, field, description string) framework.Element {
+	return templateRemap(componentID, inputs, TransformUserFilterTemplateToVRL(userTemplate), field, description)
+}
+
+func templateRemap(componentID string, inputs []string, vrlString, field, description string) framework.Element {
 	// Generate template
 	w := &strings.Builder{}
 
 	_ = UserTemplateVRLTmpl.Execute(w,
 		Template{
 			Field:     field,
-			VRLString: TransformUserTemplateToVRL(userTemplate),
+			VRLString: vrlString,
 		},
 	)
 
@@ -45,9 +54,31 @@ func TemplateRemap(componentID string, inputs []string, userTemplate, field, des
 	}
 }
 
-// TransformUserTemplateToVRL converts the user entered template to VRL compatible syntax
+var (
+	// internalLabelsReplacer replaces the labels of a record with the values from internal context, which are only
+	// available to outputs
+	internalLabelsReplacer = strings.NewReplacer(
+		".kubernetes.labels", "._internal.kubernetes.labels",
+		".kubernetes.namespace_labels", "._internal.kubernetes.namespace_labels",
+		".openshift.labels", "._internal.openshift.labels",
+	)
+	noopReplacer = strings.NewReplacer()
+)
+
+// TransformUserTemplateToVRL converts the user entered template of an output to VRL compatible syntax
 // Example: foo-{.log_type||"none"} -> "foo-" + to_string!(.log_type||"none")
 func TransformUserTemplateToVRL(userTemplate string) string {
+	return transformUserTemplateToVRL(userTemplate, internalLabelsReplacer)
+}
+
+// TransformUserFilterTemplateToVRL converts the user entered template of a filter to VRL compatible syntax. Filters
+// are applied before the internal context is populated so the paths of the template are not replaced
+// Example: foo-{.kubernetes.labels.app||"none"} -> "foo-" + to_string!(.kubernetes.labels.app||"none")
+func TransformUserFilterTemplateToVRL(userTemplate string) string {
+	return transformUserTemplateToVRL(userTemplate, noopReplacer)
+}
+
+func transformUserTemplateToVRL(userTemplate string, pathReplacer *strings.Replacer) string {
 	// Finds and replaces expressions defined in `{}` with to_string!()
 	replacedUserTemplate := ReplaceBracketWithToString(userTemplate, "to_string!(%s)")
 
@@ -66,12 +97,8 @@ func TransformUserTemplateToVRL(userTemplate string) string {
 			result = append(result, fmt.Sprintf("%q", beforePart))
 		}
 
-		// Append the to_string!() group and replace any labels with values from internal context
-		result = append(result, strings.NewReplacer(
-			".kubernetes.labels", "._internal.kubernetes.labels",
-			".kubernetes.namespace_labels", "._internal.kubernetes.namespace_labels",
-			".openshift.labels", "._internal.openshift.labels",
-		).Replace(replacedUserTemplate[match[0]:match[1]]))
+		// Append the to_string!() group and replace its paths
+		result = append(result, pathReplacer.Replace(replacedUserTemplate[match[0]:match[1]]))
 		lastIndex = match[1]
 	}
 	// Append the remaining part of the string after the last match making sure it isn't the empty string
@@ -17,5 +17,15 @@ var _ = Describe("Vector Output Template", func() {
 
 		Entry("should only add quotes and not transform template if using only a static value", `"foobar-myindex"`, `foobar-myindex`),
 		Entry("should transform template if only a dynamic value is defined", `to_string!(.foo.bar||"missing")`, `{.foo.bar||"missing"}`),
+		Entry("should replace labels with values from internal context", `"app-" + to_string!(._internal.kubernetes.labels.app||"none")`, `app-{.kubernetes.labels.app||"none"}`),
+	)
+
+	DescribeTable("transforms filter template syntax to VRL compatible string", func(expVRL, template string) {
+		Expect(TransformUserFilterTemplateToVRL(template)).To(EqualTrimLines(expVRL))
+	},
+		Entry("should not replace labels with values from internal context",
+			`"app-" + to_string!(.kubernetes.labels.app||"none") + "-" + to_string!(.kubernetes.namespace_labels.team||.openshift.labels.team||"none")`,
+			`app-{.kubernetes.labels.app||"none"}-{.kubernetes.namespace_labels.team||.openshift.labels.team||"none"}`),
+		Entry("should only add quotes if using only a static value", `"foobar"`, `foobar`),
 	)
 })
@@ -10,12 +10,16 @@ import (
 )
 
 const (
-	Component      = "component"
-	IncludeEnabled = "IncludeEnabled"
+	Component         = "component"
+	IncludeEnabled    = "IncludeEnabled"
+	VerifyCertificate = "VerifyCertificate"
 )
 
 var (
 	IncludeEnabledOption = framework.Option{Name: IncludeEnabled, Value: ""}
+
+	// VerifyCertificateOption requires the peer to present a certificate signed by the CA
+	VerifyCertificateOption = framework.Option{Name: VerifyCertificate, Value: ""}
 )
 
 type TLSConf struct {
@@ -25,6 +29,7 @@ type TLSConf struct {
 	Enabled            typehelpers.OptionalPair
 	NeedsEnabled       bool
 	InsecureSkipVerify bool
+	VerifyCertificate  bool
 	TlsMinVersion      string
 	CipherSuites       string
 	CAFilePath         string
@@ -49,6 +54,9 @@ func New(id string, spec *obs.OutputTLSSpec, secrets observability.Secrets, op f
 	if _, found := framework.HasOption(IncludeEnabled, options); found && spec != nil {
 		conf.Enabled = typehelpers.NewOptionalPair("enabled", true)
 	}
+	if _, found := framework.HasOption(VerifyCertificate, options); found {
+		conf.VerifyCertificate = true
+	}
 
 	if spec != nil {
 		conf.CAFilePath = ValuePath(spec.CA)
@@ -114,6 +122,9 @@ ciphersuites = "{{ .CipherSuites }}"
 verify_certificate = false
 verify_hostname = false
 {{- end }}
+{{- if .VerifyCertificate }}
+verify_certificate = true
+{{- end }}
 {{- if and .KeyPath .CertPath }}
 key_file = {{ .KeyPath }}
 crt_file = {{ .CertPath }}
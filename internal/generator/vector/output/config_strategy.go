@@ -2,12 +2,12 @@ package output
 
 import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
 	"time"
 )
 
 const (
-	minBufferSize   = 268435488
 	buffertTypeDisk = "disk"
 )
 
@@ -44,15 +44,32 @@ func (o Output) VisitRequest(r common.Request) common.Request {
 }
 
 // VisitBuffer modifies the buffer behavior depending upon the value
-// of the tuning.Delivery mode
+// of the tuning.Delivery mode and the buffer spec which takes precedence
 func (o Output) VisitBuffer(b common.Buffer) common.Buffer {
 	switch o.tuning.DeliveryMode {
 	case obs.DeliveryModeAtLeastOnce:
 		b.WhenFull.Value = common.BufferWhenFullBlock
 		b.Type.Value = buffertTypeDisk
-		b.MaxSize.Value = minBufferSize
+		b.MaxSize.Value = internalobs.MinDiskBufferSize
 	case obs.DeliveryModeAtMostOnce:
 		b.WhenFull.Value = common.BufferWhenFullDropNewest
 	}
+	if spec := o.tuning.Buffer; spec != nil {
+		b.Type.Value = string(spec.Type)
+		b.MaxSize.Value = nil
+		b.MaxEvents.Value = nil
+		if spec.MaxSize != nil {
+			b.MaxSize.Value = spec.MaxSize.Value()
+		}
+		if spec.MaxEvents != nil {
+			b.MaxEvents.Value = *spec.MaxEvents
+		}
+		switch spec.WhenFull {
+		case obs.BufferWhenFullBlock:
+			b.WhenFull.Value = common.BufferWhenFullBlock
+		case obs.BufferWhenFullDropNewest:
+			b.WhenFull.Value = common.BufferWhenFullDropNewest
+		}
+	}
 	return b
 }
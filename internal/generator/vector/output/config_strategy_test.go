@@ -262,6 +262,49 @@ max_size = 268435488
 				Expect(`
 [sinks.id.buffer]
 when_full = "drop_newest"
+`).To(EqualConfigFrom(common.NewBuffer(ID, output)))
+			})
+		})
+
+		Context("with a buffer spec", func() {
+
+			var newOutput = func(mode obs.DeliveryMode, buffer *obs.OutputBufferSpec) *Output {
+				return NewOutput(obs.OutputSpec{
+					Type: obs.OutputTypeElasticsearch,
+					Elasticsearch: &obs.Elasticsearch{
+						Tuning: &obs.ElasticsearchTuningSpec{
+							BaseOutputTuningSpec: obs.BaseOutputTuningSpec{
+								DeliveryMode: mode,
+								Buffer:       buffer,
+							},
+						},
+					},
+				}, nil, nil)
+			}
+
+			It("should configure a disk buffer with a max size", func() {
+				output := newOutput("", &obs.OutputBufferSpec{
+					Type:     obs.BufferTypeDisk,
+					MaxSize:  utils.GetPtr(resource.MustParse("1Gi")),
+					WhenFull: obs.BufferWhenFullDropNewest,
+				})
+				Expect(`
+[sinks.id.buffer]
+type = "disk"
+when_full = "drop_newest"
+max_size = 1073741824
+`).To(EqualConfigFrom(common.NewBuffer(ID, output)))
+			})
+			It("should take precedence over the buffer of the delivery mode", func() {
+				output := newOutput(obs.DeliveryModeAtLeastOnce, &obs.OutputBufferSpec{
+					Type:      obs.BufferTypeMemory,
+					MaxEvents: utils.GetPtr[int64](1000),
+				})
+				Expect(`
+[sinks.id.buffer]
+type = "memory"
+when_full = "block"
+max_events = 1000
 `).To(EqualConfigFrom(common.NewBuffer(ID, output)))
 			})
 		})
@@ -16,6 +16,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/http"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/kafka"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/loki"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/s3"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/splunk"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/syslog"
 	corev1 "k8s.io/api/core/v1"
@@ -43,6 +44,8 @@ func New(o obs.OutputSpec, inputs []string, secrets map[string]*corev1.Secret, s
 		els = append(els, elasticsearch.New(baseID, o, inputs, secrets, strategy, op)...)
 	case obs.OutputTypeCloudwatch:
 		els = append(els, cloudwatch.New(baseID, o, inputs, secrets, strategy, op)...)
+	case obs.OutputTypeS3:
+		els = append(els, s3.New(baseID, o, inputs, secrets, strategy, op)...)
 	case obs.OutputTypeGoogleCloudLogging:
 		els = append(els, gcl.New(baseID, o, inputs, secrets, strategy, op)...)
 	case obs.OutputTypeSplunk:
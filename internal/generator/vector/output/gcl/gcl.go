@@ -1,8 +1,14 @@
 package gcl
 
 import (
+	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
 	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/tls"
@@ -26,6 +32,23 @@ const (
 	DefaultSeverityKey = "level"
 
 	GoogleApplicationCredentialsKey = "google-application-credentials.json"
+
+	resourceTypeNode      = "k8s_node"
+	resourceTypeContainer = "k8s_container"
+	resourceField         = "gcl_resource"
+)
+
+var (
+	// containerLabels are the labels of the k8s_container resource and their default values
+	containerLabels = map[string]string{
+		"namespace_name": `{.kubernetes.namespace_name||"none"}`,
+		"pod_name":       `{.kubernetes.pod_name||"none"}`,
+		"container_name": `{.kubernetes.container_name||"none"}`,
+	}
+	// nodeLabels are the labels of the k8s_node resource and their default values
+	nodeLabels = map[string]string{
+		"node_name": `{.hostname||"none"}`,
+	}
 )
 
 type GoogleCloudLogging struct {
@@ -39,6 +62,9 @@ type GoogleCloudLogging struct {
 	SeverityKey string
 
 	CredentialsPath string
+
+	ResourceType   string
+	ResourceLabels map[string]string
 	common.RootMixin
 }
 
@@ -57,8 +83,11 @@ log_id = "{{"{{"}} _internal.{{.LogID}} {{"}}"}}"
 severity_key = "{{.SeverityKey}}"
 
 [sinks.{{.ComponentID}}.resource]
-type = "k8s_node"
-node_name = "{{"{{hostname}}"}}"
+type = "{{.ResourceType}}"
+{{- $labels := .ResourceLabels -}}
+{{- range $name := getSortedKeyFromMap .ResourceLabels}}
+{{$name}} = "{{index $labels $name}}"
+{{- end}}
 {{end}}`
 }
 
@@ -76,14 +105,21 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets observability.Sec
 		return []Element{}
 	}
 	componentID := vectorhelpers.MakeID(id, "log_id")
+	resourceID := vectorhelpers.MakeID(id, "resource")
 	g := o.GoogleCloudLogging
+	resourceType, labels := resourceTypeContainer, containerLabels
+	if ResourceType(g) == obs.GoogleCloudLoggingResourceTypeK8sNode {
+		resourceType, labels = resourceTypeNode, nodeLabels
+	}
 	gcl := &GoogleCloudLogging{
 		ComponentID:     id,
-		Inputs:          helpers.MakeInputs(componentID),
+		Inputs:          helpers.MakeInputs(resourceID),
 		LogDestination:  LogDestination(g),
 		LogID:           componentID,
 		SeverityKey:     SeverityKey(g),
-		CredentialsPath: auth(g.Authentication, secrets),
+		CredentialsPath: auth(o.Name, g.Authentication, secrets),
+		ResourceType:    resourceType,
+		ResourceLabels:  resourceLabels(labels),
 		RootMixin:       common.NewRootMixin(nil),
 	}
 	if strategy != nil {
@@ -91,6 +127,12 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets observability.Sec
 	}
 	return []Element{
 		commontemplate.TemplateRemap(componentID, inputs, o.GoogleCloudLogging.LogId, componentID, "GoogleCloudLogging LogId"),
+		Remap{
+			Desc:        "GoogleCloudLogging Resource",
+			ComponentID: resourceID,
+			Inputs:      helpers.MakeInputs(componentID),
+			VRL:         ResourceVRL(g),
+		},
 		gcl,
 		common.NewEncoding(id, ""),
 		common.NewAcknowledgments(id, strategy),
@@ -101,13 +143,134 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets observability.Sec
 	}
 }
 
-func auth(spec *obs.GoogleCloudLoggingAuthentication, secrets observability.Secrets) string {
+// ResourceType returns the type of the monitored resource of the log entries of an output
+func ResourceType(g *obs.GoogleCloudLogging) obs.GoogleCloudLoggingResourceType {
+	if g.Resource == nil || g.Resource.Type == "" {
+		return obs.GoogleCloudLoggingResourceTypeK8sContainer
+	}
+	return g.Resource.Type
+}
+
+// resourceLabels returns the sink templates of the common labels and the given labels of a resource
+func resourceLabels(labels map[string]string) map[string]string {
+	templates := map[string]string{}
+	for _, name := range append([]string{"project_id", "location", "cluster_name"}, sortedKeys(labels)...) {
+		templates[name] = fmt.Sprintf("{{ _internal.%s.%s }}", resourceField, name)
+	}
+	return templates
+}
+
+// ResourceVRL returns the VRL that evaluates the labels of the monitored resource of each log entry
+func ResourceVRL(g *obs.GoogleCloudLogging) string {
+	r := g.Resource
+	if r == nil {
+		r = &obs.GoogleCloudLoggingResource{}
+	}
+	values := map[string]string{
+		"project_id":   r.ProjectId,
+		"location":     r.Location,
+		"cluster_name": r.ClusterName,
+	}
+	if values["project_id"] == "" && g.ID.Type == obs.GoogleCloudLoggingIdTypeProject {
+		values["project_id"] = g.ID.Value
+	}
+	labels := containerLabels
+	if ResourceType(g) == obs.GoogleCloudLoggingResourceTypeK8sNode {
+		labels = nodeLabels
+	}
+	for name, value := range labels {
+		values[name] = value
+	}
+	fields := []string{}
+	for _, name := range sortedKeys(values) {
+		value := values[name]
+		if mapped, found := r.Labels[name]; found {
+			value = mapped
+		}
+		fields = append(fields, fmt.Sprintf("%q: %s", name, commontemplate.TransformUserTemplateToVRL(value)))
+	}
+	return fmt.Sprintf("._internal.%s = {%s}", resourceField, strings.Join(fields, ", "))
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func auth(outputName string, spec *obs.GoogleCloudLoggingAuthentication, secrets observability.Secrets) string {
 	if spec == nil {
 		return ""
 	}
+	if spec.WorkloadIdentity != nil {
+		return fmt.Sprintf("%q", filepath.Join(constants.CollectorConfigDir, CredentialsFile(outputName)))
+	}
 	return secrets.Path(spec.Credentials)
 }
 
+// CredentialsFile is the name of the file of the workload identity credentials of an output
+func CredentialsFile(outputName string) string {
+	return fmt.Sprintf("gcl-%s-credentials.json", outputName)
+}
+
+// externalAccount is a credential configuration file for workload identity federation
+// https://google.aip.dev/auth/4117
+type externalAccount struct {
+	Type                           string           `json:"type"`
+	Audience                       string           `json:"audience"`
+	SubjectTokenType               string           `json:"subject_token_type"`
+	TokenURL                       string           `json:"token_url"`
+	ServiceAccountImpersonationURL string           `json:"service_account_impersonation_url,omitempty"`
+	CredentialSource               credentialSource `json:"credential_source"`
+}
+
+type credentialSource struct {
+	File   string            `json:"file"`
+	Format map[string]string `json:"format"`
+}
+
+// WorkloadIdentityCredentials returns the credential configuration files of the outputs authenticating with a workload
+// identity, keyed by file name. The files reference the token of the workload identity visible to the collector
+func WorkloadIdentityCredentials(outputs []obs.OutputSpec) (map[string]string, error) {
+	files := map[string]string{}
+	for _, o := range outputs {
+		if o.Type != obs.OutputTypeGoogleCloudLogging || o.GoogleCloudLogging == nil || o.GoogleCloudLogging.Authentication == nil {
+			continue
+		}
+		wi := o.GoogleCloudLogging.Authentication.WorkloadIdentity
+		if wi == nil {
+			continue
+		}
+		// the projected token of the forwarder service account
+		tokenPath := filepath.Join(constants.ServiceAccountSecretPath, constants.TokenKey)
+		if wi.Token.From == obs.BearerTokenFromSecret && wi.Token.Secret != nil {
+			tokenPath = filepath.Join(constants.CollectorSecretsDir, wi.Token.Secret.Name, wi.Token.Secret.Key)
+		}
+		account := externalAccount{
+			Type:             "external_account",
+			Audience:         wi.Audience,
+			SubjectTokenType: "urn:ietf:params:oauth:token-type:jwt",
+			TokenURL:         "https://sts.googleapis.com/v1/token",
+			CredentialSource: credentialSource{
+				File:   tokenPath,
+				Format: map[string]string{"type": "text"},
+			},
+		}
+		if wi.ServiceAccountEmail != "" {
+			account.ServiceAccountImpersonationURL = fmt.Sprintf("https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/%s:generateAccessToken", wi.ServiceAccountEmail)
+		}
+		data, err := json.MarshalIndent(account, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		files[CredentialsFile(o.Name)] = string(data)
+	}
+	return files, nil
+}
+
 // LogDestination is one of BillingAccountID, OrganizationID, FolderID, or ProjectID in that order
 func LogDestination(g *obs.GoogleCloudLogging) Element {
 	var key string
@@ -97,10 +97,104 @@ var _ = Describe("Generate Vector config", func() {
 		Entry("with custom logId", func(spec *obs.OutputSpec) {
 			spec.GoogleCloudLogging.LogId = `my-id{.log_type||"none"}`
 		}, false, framework.NoOptions, "gcl_with_custom_logid.toml"),
+		Entry("with k8s node resource", func(spec *obs.OutputSpec) {
+			spec.GoogleCloudLogging.Resource = &obs.GoogleCloudLoggingResource{
+				Type:        obs.GoogleCloudLoggingResourceTypeK8sNode,
+				ProjectId:   "project-1",
+				Location:    "us-central1",
+				ClusterName: "cluster-1",
+			}
+		}, false, framework.NoOptions, "gcl_with_node_resource.toml"),
+		Entry("with k8s container resource", func(spec *obs.OutputSpec) {
+			spec.GoogleCloudLogging.ID = obs.GoogleCloudLoggingId{
+				Type:  obs.GoogleCloudLoggingIdTypeProject,
+				Value: "project-1",
+			}
+			spec.GoogleCloudLogging.Resource = &obs.GoogleCloudLoggingResource{
+				Type:        obs.GoogleCloudLoggingResourceTypeK8sContainer,
+				Location:    "us-central1",
+				ClusterName: "cluster-1",
+				Labels: map[string]string{
+					"container_name": `{.kubernetes.labels.app||.kubernetes.container_name||"none"}`,
+				},
+			}
+		}, false, framework.NoOptions, "gcl_with_container_resource.toml"),
+		Entry("with workload identity", func(spec *obs.OutputSpec) {
+			spec.GoogleCloudLogging.Authentication = &obs.GoogleCloudLoggingAuthentication{
+				WorkloadIdentity: &obs.GoogleCloudLoggingWorkloadIdentity{
+					Audience: "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool-1/providers/provider-1",
+					Token: obs.BearerToken{
+						From: obs.BearerTokenFromServiceAccount,
+					},
+				},
+			}
+		}, false, framework.NoOptions, "gcl_with_workload_identity.toml"),
 		Entry("with tuning", func(spec *obs.OutputSpec) {
 			spec.GoogleCloudLogging.Tuning = &obs.GoogleCloudLoggingTuningSpec{
 				BaseOutputTuningSpec: *baseTune,
 			}
 		}, true, framework.NoOptions, "gcl_with_tuning.toml"),
 	)
+
+	Context("#WorkloadIdentityCredentials", func() {
+		var (
+			withKey      obs.OutputSpec
+			withIdentity obs.OutputSpec
+		)
+		BeforeEach(func() {
+			withKey = initOutput()
+			withKey.Name = "gcl_2"
+			withIdentity = initOutput()
+			withIdentity.GoogleCloudLogging.Authentication = &obs.GoogleCloudLoggingAuthentication{
+				WorkloadIdentity: &obs.GoogleCloudLoggingWorkloadIdentity{
+					Audience: "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool-1/providers/provider-1",
+					Token: obs.BearerToken{
+						From: obs.BearerTokenFromServiceAccount,
+					},
+				},
+			}
+		})
+
+		It("should generate the credential configuration of the outputs with a workload identity using the projected service account token", func() {
+			files, err := gcl.WorkloadIdentityCredentials([]obs.OutputSpec{withKey, withIdentity})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+			Expect(files).To(HaveKey("gcl-gcl_1-credentials.json"))
+			Expect(files["gcl-gcl_1-credentials.json"]).To(MatchJSON(`{
+  "type": "external_account",
+  "audience": "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool-1/providers/provider-1",
+  "subject_token_type": "urn:ietf:params:oauth:token-type:jwt",
+  "token_url": "https://sts.googleapis.com/v1/token",
+  "credential_source": {
+    "file": "/var/run/ocp-collector/serviceaccount/token",
+    "format": {"type": "text"}
+  }
+}`))
+		})
+
+		It("should generate the credential configuration of a workload identity impersonating a service account with a token from a secret", func() {
+			withIdentity.GoogleCloudLogging.Authentication.WorkloadIdentity.ServiceAccountEmail = "collector@project-1.iam.gserviceaccount.com"
+			withIdentity.GoogleCloudLogging.Authentication.WorkloadIdentity.Token = obs.BearerToken{
+				From: obs.BearerTokenFromSecret,
+				Secret: &obs.BearerTokenSecretKey{
+					Name: secretName,
+					Key:  "token",
+				},
+			}
+			files, err := gcl.WorkloadIdentityCredentials([]obs.OutputSpec{withIdentity})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files["gcl-gcl_1-credentials.json"]).To(MatchJSON(`{
+  "type": "external_account",
+  "audience": "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool-1/providers/provider-1",
+  "subject_token_type": "urn:ietf:params:oauth:token-type:jwt",
+  "token_url": "https://sts.googleapis.com/v1/token",
+  "service_account_impersonation_url": "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/collector@project-1.iam.gserviceaccount.com:generateAccessToken",
+  "credential_source": {
+    "file": "/var/run/ocp-collector/secrets/gcl-1/token",
+    "format": {"type": "text"}
+  }
+}`))
+		})
+	})
+
 })
@@ -2,6 +2,7 @@ package otlp
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
 	"strings"
 
@@ -17,10 +18,21 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/tls"
 )
 
+const (
+	// ProtobufDescriptorFile is the location of the OTLP protobuf descriptor set in the collector image
+	ProtobufDescriptorFile = "/usr/share/vector/opentelemetry/logs_service.desc"
+
+	// ProtobufMessageType is the OTLP protobuf message sent by the collector
+	ProtobufMessageType = "opentelemetry.proto.collector.logs.v1.ExportLogsServiceRequest"
+
+	contentTypeProtobuf = "application/x-protobuf"
+)
+
 type Otlp struct {
 	ComponentID      string
 	Inputs           string
 	URI              string
+	Protobuf         bool
 	common.RootMixin //TODO: remove??
 }
 
@@ -35,13 +47,79 @@ type = "http"
 inputs = {{.Inputs}}
 uri = "{{.URI}}"
 method = "post"
+{{- if not .Protobuf}}
 payload_prefix = "{\"resourceLogs\":"
 payload_suffix = "}"
+{{- end}}
 {{.Compression}}
 {{end}}
 `
 }
 
+// OtlpGRPC sends ExportLogsServiceRequests using OTLP/gRPC
+type OtlpGRPC struct {
+	ComponentID string
+	Inputs      string
+	Endpoint    string
+	common.RootMixin
+}
+
+func (p OtlpGRPC) Name() string {
+	return "vectorOtlpGRPCTemplate"
+}
+
+func (p OtlpGRPC) Template() string {
+	return `{{define "` + p.Name() + `" -}}
+[sinks.{{.ComponentID}}]
+type = "opentelemetry"
+inputs = {{.Inputs}}
+protocol.type = "grpc"
+protocol.endpoint = "{{.Endpoint}}"
+{{.Compression}}
+{{end}}
+`
+}
+
+func (p *OtlpGRPC) SetCompression(algo string) {
+	p.Compression.Value = algo
+}
+
+// ProtobufEncoding encodes events as OTLP protobuf messages
+type ProtobufEncoding struct {
+	ID          string
+	DescFile    string
+	MessageType string
+}
+
+func (e ProtobufEncoding) Name() string {
+	return "otlpProtobufEncoding"
+}
+
+func (e ProtobufEncoding) Template() string {
+	return `{{define "` + e.Name() + `" -}}
+[sinks.{{.ID}}.encoding]
+codec = "protobuf"
+protobuf.desc_file = "{{.DescFile}}"
+protobuf.message_type = "{{.MessageType}}"
+
+[sinks.{{.ID}}.framing]
+method = "bytes"
+{{end}}`
+}
+
+// Protocol returns the transport of the OTLP requests of an output
+func Protocol(o obs.OTLP) obs.OTLPProtocol {
+	if o.Protocol == "" {
+		return obs.OTLPProtocolHTTP
+	}
+	return o.Protocol
+}
+
+// IsProtobuf returns true when the OTLP requests of an output are encoded using protobuf
+func IsProtobuf(o obs.OTLP) bool {
+	return Protocol(o) == obs.OTLPProtocolGRPC || o.Encoding == obs.OTLPEncodingProtobuf
+}
+
 // TODO: test this for otlp
 func (p *Otlp) SetCompression(algo string) {
 	p.Compression.Value = algo
@@ -112,26 +190,74 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets observability.Sec
 		reduceSourceID,
 		reduceHostID,
 	}))
+	if IsProtobuf(*o.OTLP) {
+		exportLogsRequestID := vectorhelpers.MakeID(id, "export", "logs", "request")
+		els = append(els, FormatExportLogsRequest(exportLogsRequestID, []string{formatResourceLogsID}))
+		if Protocol(*o.OTLP) == obs.OTLPProtocolGRPC {
+			return append(els, grpcSinkElements(id, o, []string{exportLogsRequestID}, secrets, strategy, op)...)
+		}
+		formatResourceLogsID = exportLogsRequestID
+	}
 	// Create sink and wrap in `resourceLogs`
 	sink := Output(id, o, []string{formatResourceLogsID}, secrets, op)
 	if strategy != nil {
 		strategy.VisitSink(sink)
 	}
+	var encoding Element = common.NewEncoding(id, common.CodecJSON)
+	request := common.NewRequest(id, strategy)
+	if sink.Protobuf {
+		encoding = ProtobufEncoding{
+			ID:          id,
+			DescFile:    ProtobufDescriptorFile,
+			MessageType: ProtobufMessageType,
+		}
+		request.SetHeaders(map[string]string{"Content-Type": contentTypeProtobuf})
+	}
 	return MergeElements(
 		els,
 		[]Element{
 			sink,
-			common.NewEncoding(id, common.CodecJSON),
+			encoding,
 			common.NewAcknowledgments(id, strategy),
 			common.NewBatch(id, strategy),
 			common.NewBuffer(id, strategy),
-			common.NewRequest(id, strategy),
+			request,
 			tls.New(id, o.TLS, secrets, op),
 			auth.HTTPAuth(id, o.OTLP.Authentication, secrets, op),
 		},
 	)
 }
 
+func grpcSinkElements(id string, o obs.OutputSpec, inputs []string, secrets observability.Secrets, strategy common.ConfigStrategy, op Options) []Element {
+	sink := &OtlpGRPC{
+		ComponentID: id,
+		Inputs:      vectorhelpers.MakeInputs(inputs...),
+		Endpoint:    grpcEndpoint(o.OTLP.URL),
+		RootMixin:   common.NewRootMixin(nil),
+	}
+	if strategy != nil {
+		strategy.VisitSink(sink)
+	}
+	return []Element{
+		sink,
+		common.NewAcknowledgments(id, strategy),
+		common.NewBatch(id, strategy),
+		common.NewBuffer(id, strategy),
+		common.NewRequest(id, strategy),
+		tls.New(id, o.TLS, secrets, op),
+		auth.HTTPAuth(id, o.OTLP.Authentication, secrets, op),
+	}
+}
+
+// grpcEndpoint removes the path of an OTLP/gRPC URL
+func grpcEndpoint(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+}
+
 func RouteBySource(id string, inputs []string) Element {
 	// TODO: refactor based on existing map of logSourceTypes?
 	logSources := []string{
@@ -162,6 +288,7 @@ func Output(id string, o obs.OutputSpec, inputs []string, secrets observability.
 		ComponentID: id,
 		Inputs:      vectorhelpers.MakeInputs(inputs...),
 		URI:         o.OTLP.URL,
+		Protobuf:    IsProtobuf(*o.OTLP),
 		RootMixin:   common.NewRootMixin(nil),
 	}
 }
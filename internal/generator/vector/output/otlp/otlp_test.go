@@ -142,5 +142,63 @@ var _ = Describe("Generate vector config", func() {
 			},
 			"otlp_with_auth_basic.toml",
 		),
+		Entry("with http protocol and json encoding",
+			nil,
+			framework.NoOptions,
+			false,
+			func(spec *obs.OutputSpec) {
+				spec.OTLP.Protocol = obs.OTLPProtocolHTTP
+				spec.OTLP.Encoding = obs.OTLPEncodingJSON
+			},
+			"otlp_all.toml",
+		),
+		Entry("with protobuf encoding",
+			nil,
+			framework.NoOptions,
+			false,
+			func(spec *obs.OutputSpec) {
+				spec.OTLP.Encoding = obs.OTLPEncodingProtobuf
+			},
+			"otlp_protobuf.toml",
+		),
+		Entry("with http protocol and protobuf encoding",
+			nil,
+			framework.NoOptions,
+			false,
+			func(spec *obs.OutputSpec) {
+				spec.OTLP.Protocol = obs.OTLPProtocolHTTP
+				spec.OTLP.Encoding = obs.OTLPEncodingProtobuf
+			},
+			"otlp_protobuf.toml",
+		),
+		Entry("with grpc protocol",
+			nil,
+			framework.NoOptions,
+			true,
+			func(spec *obs.OutputSpec) {
+				spec.OTLP.URL = "https://localhost:4317"
+				spec.OTLP.Protocol = obs.OTLPProtocolGRPC
+				spec.OTLP.Tuning = &obs.OTLPTuningSpec{
+					BaseOutputTuningSpec: *baseTune,
+					Compression:          "gzip",
+				}
+			},
+			"otlp_grpc.toml",
+		),
+		Entry("with grpc protocol and protobuf encoding",
+			nil,
+			framework.NoOptions,
+			true,
+			func(spec *obs.OutputSpec) {
+				spec.OTLP.URL = "https://localhost:4317/v1/logs"
+				spec.OTLP.Protocol = obs.OTLPProtocolGRPC
+				spec.OTLP.Encoding = obs.OTLPEncodingProtobuf
+				spec.OTLP.Tuning = &obs.OTLPTuningSpec{
+					BaseOutputTuningSpec: *baseTune,
+					Compression:          "gzip",
+				}
+			},
+			"otlp_grpc.toml",
+		),
 	)
 })
@@ -262,3 +262,29 @@ func FormatResourceLog(id string, inputs []string) Element {
 `),
 	}
 }
+
+// FormatExportLogsRequest converts a resource object to an ExportLogsServiceRequest using the field names and types
+// of the OTLP protobuf definitions
+func FormatExportLogsRequest(id string, inputs []string) Element {
+	return elements.Remap{
+		Desc:        "Convert resource object to an OTLP protobuf ExportLogsServiceRequest",
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		VRL: strings.TrimSpace(`
+. = map_keys(., recursive: true) -> |key| { snakecase(key) }
+scope_logs = []
+for_each(array!(.scope_logs)) -> |_index, scope| {
+  records = []
+  for_each(array!(scope.log_records)) -> |_index, record| {
+    record.time_unix_nano = to_int!(record.time_unix_nano)
+    record.observed_time_unix_nano = to_int!(record.observed_time_unix_nano)
+    records = push(records, record)
+  }
+  scope.log_records = records
+  scope_logs = push(scope_logs, scope)
+}
+.scope_logs = scope_logs
+. = {"resource_logs": [.]}
+`),
+	}
+}
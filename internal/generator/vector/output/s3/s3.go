@@ -0,0 +1,113 @@
+package s3
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	genhelper "github.com/openshift/cluster-logging-operator/internal/generator/helpers"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/cloudwatch"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/tls"
+)
+
+const (
+	// DefaultKeyPrefix groups the objects in the bucket by log type
+	DefaultKeyPrefix = `{.log_type||"unknown"}/`
+
+	defaultCompression = "gzip"
+)
+
+type S3 struct {
+	Desc           string
+	ComponentID    string
+	Inputs         string
+	Region         string
+	Bucket         string
+	KeyPrefix      string
+	EndpointConfig Element
+	SecurityConfig Element
+	common.RootMixin
+}
+
+func (e S3) Name() string {
+	return "s3Template"
+}
+
+func (e S3) Template() string {
+
+	return `{{define "` + e.Name() + `" -}}
+{{if .Desc -}}
+# {{.Desc}}
+{{end -}}
+[sinks.{{.ComponentID}}]
+type = "aws_s3"
+inputs = {{.Inputs}}
+region = "{{.Region}}"
+bucket = "{{.Bucket}}"
+key_prefix = "{{"{{"}} _internal.{{.KeyPrefix}} {{"}}"}}"
+{{.Compression}}
+framing.method = "newline_delimited"
+{{compose_one .SecurityConfig}}
+healthcheck.enabled = false
+{{compose_one .EndpointConfig}}
+{{- end}}
+`
+}
+
+func (e *S3) SetCompression(algo string) {
+	e.Compression.Value = algo
+}
+
+func New(id string, o obs.OutputSpec, inputs []string, secrets observability.Secrets, strategy common.ConfigStrategy, op Options) []Element {
+	if genhelper.IsDebugOutput(op) {
+		return []Element{
+			Debug(id, vectorhelpers.MakeInputs(inputs...)),
+		}
+	}
+	keyPrefixID := vectorhelpers.MakeID(id, "key_prefix")
+	keyPrefix := o.S3.KeyPrefix
+	if keyPrefix == "" {
+		keyPrefix = DefaultKeyPrefix
+	}
+	sink := sink(id, o, []string{keyPrefixID}, secrets, keyPrefixID)
+	if strategy != nil {
+		strategy.VisitSink(sink)
+	}
+
+	return []Element{
+		commontemplate.TemplateRemap(keyPrefixID, inputs, keyPrefix, keyPrefixID, "S3 Key Prefix"),
+		sink,
+		common.NewEncoding(id, common.CodecJSON),
+		common.NewAcknowledgments(id, strategy),
+		common.NewBatch(id, strategy),
+		common.NewBuffer(id, strategy),
+		common.NewRequest(id, strategy),
+		tls.New(id, o.TLS, secrets, op),
+	}
+}
+
+func sink(id string, o obs.OutputSpec, inputs []string, secrets observability.Secrets, keyPrefix string) *S3 {
+	return &S3{
+		Desc:           "Amazon S3",
+		ComponentID:    id,
+		Inputs:         vectorhelpers.MakeInputs(inputs...),
+		Region:         o.S3.Region,
+		Bucket:         o.S3.Bucket,
+		KeyPrefix:      keyPrefix,
+		SecurityConfig: authConfig(o.S3.Authentication, secrets),
+		EndpointConfig: cloudwatch.Endpoint{URL: o.S3.URL},
+		RootMixin:      common.NewRootMixin(defaultCompression),
+	}
+}
+
+func authConfig(auth *obs.CloudwatchAuthentication, secrets observability.Secrets) Element {
+	authConfig := cloudwatch.NewAuth()
+	if auth != nil && auth.Type == obs.CloudwatchAuthTypeAccessKey {
+		authConfig.KeyID.Value = vectorhelpers.SecretFrom(&auth.AWSAccessKey.KeyId)
+		authConfig.KeySecret.Value = vectorhelpers.SecretFrom(&auth.AWSAccessKey.KeySecret)
+	}
+	return authConfig
+}
@@ -0,0 +1,37 @@
+package splunk
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+)
+
+// Acknowledgments enables end-to-end acknowledgements of the sink backed by HEC indexer acknowledgements
+type Acknowledgments struct {
+	common.Acknowledgments
+	IndexerAcknowledgements bool
+}
+
+// NewAcknowledgments enables indexer acknowledgements when the output is tuned for atLeastOnce delivery
+func NewAcknowledgments(id string, s *obs.Splunk, strategy common.ConfigStrategy) Acknowledgments {
+	a := Acknowledgments{
+		Acknowledgments: common.NewAcknowledgments(id, strategy),
+	}
+	if s != nil && s.Tuning != nil && s.Tuning.DeliveryMode == obs.DeliveryModeAtLeastOnce {
+		a.Enabled = true
+		a.IndexerAcknowledgements = true
+	}
+	return a
+}
+
+func (a Acknowledgments) Template() string {
+	if !a.Enabled {
+		return `{{define "` + a.Name() + `" -}}{{end}}`
+	}
+	return `{{define "` + a.Name() + `" -}}
+[sinks.{{.ID}}.acknowledgements]
+enabled = {{.Enabled}}
+{{- if .IndexerAcknowledgements}}
+indexer_acknowledgements_enabled = true
+{{- end}}
+{{end}}`
+}
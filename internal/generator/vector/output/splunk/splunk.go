@@ -3,6 +3,7 @@ package splunk
 import (
 	"fmt"
 	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
@@ -21,6 +22,11 @@ type Splunk struct {
 	Endpoint     string
 	DefaultToken string
 	Index        Element
+	Source       Element
+	SourceType   Element
+	HostKey      Element
+	Target       Element
+	Indexed      Element
 	common.RootMixin
 }
 
@@ -37,6 +43,11 @@ endpoint = "{{.Endpoint}}"
 {{.Compression}}
 default_token = "{{.DefaultToken}}"
 {{kv .Index -}}
+{{kv .Source -}}
+{{kv .SourceType -}}
+{{kv .HostKey -}}
+{{kv .Target -}}
+{{kv .Indexed -}}
 timestamp_key = "@timestamp"
 {{end}}`
 }
@@ -53,39 +64,78 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets observability.Sec
 	}
 
 	timestampID := vectorhelpers.MakeID(id, "timestamp")
+	els := []Element{
+		FixTimestampFormat(timestampID, inputs),
+	}
 
-	var indexTemplate Element
-	splunkSink := sink(id, o, []string{timestampID}, "", secrets, op)
-	if hasIndexKey(o.Splunk) {
-		splunkIndexID := vectorhelpers.MakeID(id, "splunk_index")
-		indexTemplate = commontemplate.TemplateRemap(splunkIndexID, []string{timestampID}, o.Splunk.Index, splunkIndexID, "Splunk Index")
-		splunkSink = sink(id, o, []string{splunkIndexID}, splunkIndexID, secrets, op)
+	// Each templated field is evaluated by its own remap, chained ahead of the sink
+	templates := map[string]string{}
+	sinkInputs := []string{timestampID}
+	for _, t := range templatedFields(o.Splunk) {
+		templateID := vectorhelpers.MakeID(id, t.field)
+		els = append(els, commontemplate.TemplateRemap(templateID, sinkInputs, t.value, templateID, t.desc))
+		templates[t.field] = templateID
+		sinkInputs = []string{templateID}
 	}
 
+	splunkSink := sink(id, o, sinkInputs, templates, secrets, op)
 	if strategy != nil {
 		strategy.VisitSink(splunkSink)
 	}
-	return []Element{
-		FixTimestampFormat(timestampID, inputs),
-		indexTemplate,
+	return append(els,
 		splunkSink,
 		common.NewEncoding(id, common.CodecJSON),
-		common.NewAcknowledgments(id, strategy),
+		NewAcknowledgments(id, o.Splunk, strategy),
 		common.NewBatch(id, strategy),
 		common.NewBuffer(id, strategy),
 		common.NewRequest(id, strategy),
 		tls.New(id, o.TLS, secrets, op),
+	)
+}
+
+type templatedField struct {
+	field string
+	value string
+	desc  string
+}
+
+// templatedFields returns the user templates of the sink fields which support dynamic values
+func templatedFields(s *obs.Splunk) (fields []templatedField) {
+	if s == nil {
+		return nil
 	}
+	for _, t := range []templatedField{
+		{field: "splunk_index", value: s.Index, desc: "Splunk Index"},
+		{field: "splunk_source", value: s.Source, desc: "Splunk Source"},
+		{field: "splunk_sourcetype", value: s.SourceType, desc: "Splunk Sourcetype"},
+		{field: "splunk_host", value: s.Host, desc: "Splunk Host"},
+	} {
+		if t.value != "" {
+			fields = append(fields, t)
+		}
+	}
+	return fields
 }
 
-func sink(id string, o obs.OutputSpec, inputs []string, index string, secrets observability.Secrets, op Options) *Splunk {
+func sink(id string, o obs.OutputSpec, inputs []string, templates map[string]string, secrets observability.Secrets, op Options) *Splunk {
 	s := &Splunk{
 		ComponentID: id,
 		Inputs:      vectorhelpers.MakeInputs(inputs...),
 		Endpoint:    o.Splunk.URL,
-		Index:       Tenant(o.Splunk, index),
+		Index:       Tenant(o.Splunk, templates["splunk_index"]),
+		Source:      templateKV("source", templates["splunk_source"]),
+		SourceType:  templateKV("sourcetype", templates["splunk_sourcetype"]),
+		HostKey:     Nil,
+		Target:      Nil,
+		Indexed:     IndexedFields(o.Splunk),
 		RootMixin:   common.NewRootMixin("none"),
 	}
+	if host, found := templates["splunk_host"]; found {
+		s.HostKey = KV("host_key", fmt.Sprintf(`"_internal.%s"`, host))
+	}
+	if o.Splunk.EndpointTarget == obs.SplunkEndpointTargetRaw {
+		s.Target = KV("endpoint_target", fmt.Sprintf("%q", obs.SplunkEndpointTargetRaw))
+	}
 	authentication := o.Splunk.Authentication
 	if authentication != nil && authentication.Token != nil {
 		s.DefaultToken = vectorhelpers.SecretFrom(authentication.Token)
@@ -93,6 +143,25 @@ func sink(id string, o obs.OutputSpec, inputs []string, index string, secrets ob
 	return s
 }
 
+func templateKV(key, field string) Element {
+	if field == "" {
+		return Nil
+	}
+	return KV(key, fmt.Sprintf(`"{{ ._internal.%s }}"`, field))
+}
+
+// IndexedFields is the list of log fields sent as HEC indexed fields
+func IndexedFields(s *obs.Splunk) Element {
+	if s == nil || len(s.IndexedFields) == 0 || s.EndpointTarget == obs.SplunkEndpointTargetRaw {
+		return Nil
+	}
+	fields := make([]string, len(s.IndexedFields))
+	for i, f := range s.IndexedFields {
+		fields[i] = strings.TrimPrefix(string(f), ".")
+	}
+	return KV("indexed_fields", vectorhelpers.MakeInputs(fields...))
+}
+
 func FixTimestampFormat(componentID string, inputs []string) Element {
 	var vrl = `
 ts, err = parse_timestamp(.@timestamp,"%+")
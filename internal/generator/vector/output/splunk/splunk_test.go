@@ -107,6 +107,18 @@ var _ = Describe("Generating vector config for Splunk output", func() {
 		Entry("with custom static & dynamic index", "splunk_sink_with_custom_index_dedot.toml", framework.NoOptions, false, func(spec *obs.OutputSpec) {
 			spec.Splunk.Index = `foo-{.kubernetes.namespace_labels."test/logging.io"||"missing"}`
 		}),
+		Entry("with source, sourcetype and host", "splunk_sink_with_source_sourcetype_host.toml", framework.NoOptions, false, func(spec *obs.OutputSpec) {
+			spec.Splunk.Index = "foo"
+			spec.Splunk.Source = `{.log_source||"unknown"}`
+			spec.Splunk.SourceType = `openshift:{.log_type||"none"}`
+			spec.Splunk.Host = `{.hostname||"missing"}`
+		}),
+		Entry("with indexed fields", "splunk_sink_with_indexed_fields.toml", framework.NoOptions, false, func(spec *obs.OutputSpec) {
+			spec.Splunk.IndexedFields = []obs.FieldPath{".log_type", `.kubernetes.labels."app.kubernetes.io/name"`}
+		}),
+		Entry("with the raw endpoint", "splunk_sink_with_raw_endpoint.toml", framework.NoOptions, false, func(spec *obs.OutputSpec) {
+			spec.Splunk.EndpointTarget = obs.SplunkEndpointTargetRaw
+		}),
 		Entry("with tuning", "splunk_tune.toml", framework.NoOptions, true, func(spec *obs.OutputSpec) {
 			spec.Splunk.Tuning = &obs.SplunkTuningSpec{
 				BaseOutputTuningSpec: *baseTune,
@@ -0,0 +1,70 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+// transformFailure is the component that annotates the events rerouted from an output to its transform failure output
+type transformFailure struct {
+	id string
+}
+
+func (d transformFailure) InputIDs() []string {
+	return []string{d.id}
+}
+
+// TransformFailureOutputRef is the name of the output where events are rerouted when they can not be prepared for delivery
+func (o *Output) TransformFailureOutputRef() string {
+	return o.spec.TransformFailureOutputRef
+}
+
+// TransformFailure returns the component providing the rerouted events of the output or nil if the output does not reroute
+// any event
+func (o *Output) TransformFailure() helpers.InputComponent {
+	if _, dropped := o.elements(); len(dropped) > 0 {
+		return transformFailure{id: transformFailureID(o.spec.Name)}
+	}
+	return nil
+}
+
+// elements generates the elements of the output and, when a transform failure output is spec'd, enables rerouting of
+// events that fail the remaps of the output.  It returns the ids of the rerouted outputs
+func (o *Output) elements() (els []Element, dropped []string) {
+	els = New(o.spec, o.inputIDs, o.secrets, o, o.op)
+	if o.spec.TransformFailureOutputRef == "" {
+		return els, nil
+	}
+	for i, el := range els {
+		if remap, ok := el.(elements.Remap); ok {
+			remap.RerouteDropped = true
+			els[i] = remap
+			dropped = append(dropped, remap.ComponentID+".dropped")
+		}
+	}
+	return els, dropped
+}
+
+func transformFailureID(outputName string) string {
+	return helpers.MakeID(helpers.MakeOutputID(outputName), "transform_failure")
+}
+
+// NewTransformFailure annotates the rerouted events with the name of the output and the reason they were rerouted
+func NewTransformFailure(outputName string, inputs []string) Element {
+	vrl := strings.TrimSpace(fmt.Sprintf(`
+.transform_failure.output = %q
+.transform_failure.reason = %%dropped.reason
+.transform_failure.message = %%dropped.message
+.transform_failure.component_id = %%dropped.component_id
+`, outputName))
+	return elements.Remap{
+		Desc:        "Transform failure events of output " + outputName,
+		ComponentID: transformFailureID(outputName),
+		Inputs:      helpers.MakeInputs(inputs...),
+		VRL:         vrl,
+	}
+}
@@ -0,0 +1,50 @@
+package output
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("output/transform_failure.go", func() {
+
+	var (
+		newOutput = func(transformFailure string) *Output {
+			o := NewOutput(obs.OutputSpec{
+				Type: obs.OutputTypeLoki,
+				Name: "default-loki-apps",
+				Loki: &obs.Loki{
+					URLSpec: obs.URLSpec{
+						URL: "http://lokistack-dev-gateway-http.openshift-logging.svc:8080/api/logs/v1/application",
+					},
+				},
+				TransformFailureOutputRef: transformFailure,
+			}, nil, framework.Options{})
+			o.inputIDs = []string{"application"}
+			return o
+		}
+	)
+
+	It("should reroute the events dropped by the output to the transform failure output", func() {
+		exp, err := tomlContent.ReadFile("transform_failure_loki.toml")
+		Expect(err).To(BeNil())
+		o := newOutput("archive")
+		Expect(string(exp)).To(EqualConfigFrom(o.Elements()))
+		Expect(o.TransformFailureOutputRef()).To(Equal("archive"))
+		Expect(o.TransformFailure().InputIDs()).To(Equal([]string{"output_default_loki_apps_transform_failure"}))
+	})
+
+	It("should not reroute any events without a transform failure output", func() {
+		o := newOutput("")
+		Expect(o.TransformFailure()).To(BeNil())
+		Expect(o.Elements()).To(HaveLen(len(newOutput("archive").Elements()) - 1))
+		for _, el := range o.Elements() {
+			if remap, ok := el.(elements.Remap); ok {
+				Expect(remap.RerouteDropped).To(BeFalse(), "Exp. %s to pass on the events that fail processing", remap.ComponentID)
+			}
+		}
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/metrics"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output"
 	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
@@ -32,6 +33,15 @@ func (o *Pipeline) Elements() []framework.Element {
 	return elements
 }
 
+// MetricIDs returns the IDs of the components emitting the metrics of the metrics filters of the pipeline
+func (o *Pipeline) MetricIDs() []string {
+	ids := []string{}
+	for _, pf := range o.Filters {
+		ids = append(ids, pf.metricIDs...)
+	}
+	return ids
+}
+
 func NewPipeline(index int, p obs.PipelineSpec, inputs map[string]helpers.InputComponent, outputs map[string]*output.Output, filters map[string]*filter.InternalFilterSpec, inputSpecs []obs.InputSpec) *Pipeline {
 	pipeline := &Pipeline{
 		PipelineSpec: p,
@@ -155,6 +165,9 @@ type PipelineFilter struct {
 
 	//transformFactory is a function that takes input IDs and returns a transform
 	transformFactory func(...string) framework.Element
+
+	// metricIDs are the IDs of the components emitting metrics generated by the filter
+	metricIDs []string
 }
 
 func (pf *PipelineFilter) ID() string {
@@ -171,12 +184,16 @@ func (pf *PipelineFilter) AddInputFrom(n helpers.InputComponent) {
 func NewPipelineFilter(pipelineName, filterRef string, spec filter.InternalFilterSpec, pipeline obs.PipelineSpec) *PipelineFilter {
 	ids := []string{helpers.MakePipelineID(pipelineName, filterRef)}
 	if spec.SuppliesTransform {
-		return &PipelineFilter{
+		pf := &PipelineFilter{
 			ids: ids,
 			transformFactory: func(inputs ...string) framework.Element {
 				return spec.TranformFactory(ids[0], inputs...)
 			},
 		}
+		if spec.FilterSpec != nil && spec.Type == obs.FilterTypeMetrics {
+			pf.metricIDs = []string{metrics.MetricID(ids[0])}
+		}
+		return pf
 	}
 
 	if vrl, err := spec.RemapFilter.VRL(); err != nil {
@@ -205,5 +205,28 @@ var _ = Describe("pipeline/adapter.go", func() {
 			Expect(adapter.Filters).To(HaveLen(4), "expected journal, viaq, drop and dedot filters to be added to the pipeline")
 			Expect(mustLoad("adapter_test_drop_filter.toml")).To(EqualConfigFrom(adapter.Elements()))
 		})
+
+		It("should expose the metrics of a metrics filter spec'd for the pipeline", func() {
+			inputSpecs := []obs.InputSpec{
+				{Name: "app-in", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+			}
+			adapter := NewPipeline(0, obs.PipelineSpec{
+				Name:       "mypipeline",
+				InputRefs:  []string{inputSpecs[0].Name},
+				FilterRefs: []string{"my-metrics"},
+			}, map[string]helpers.InputComponent{
+				inputSpecs[0].Name: input.NewInput(inputSpecs[0], secrets, "", factory.ForwarderResourceNames{CommonName: constants.CollectorName}, nil),
+			}, map[string]*output.Output{},
+				filter.NewInternalFilterMap(map[string]*obs.FilterSpec{
+					"my-metrics": {
+						Name:              "my-metrics",
+						Type:              obs.FilterTypeMetrics,
+						MetricsFilterSpec: &obs.MetricsFilterSpec{Name: "app_logs_total"},
+					},
+				}),
+				inputSpecs,
+			)
+			Expect(adapter.MetricIDs()).To(Equal([]string{"pipeline_mypipeline_my_metrics_1_limit"}))
+		})
 	})
 })
@@ -1,6 +1,9 @@
 package source
 
 import (
+	"fmt"
+	"strings"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
@@ -8,13 +11,22 @@ import (
 )
 
 func NewHttpSource(id, inputName string, input obs.InputSpec) (framework.Element, string) {
-	return HttpReceiver{
+	receiver := HttpReceiver{
 		ID:            id,
 		InputName:     inputName,
 		ListenAddress: helpers.ListenOnAllLocalInterfacesAddress(),
 		ListenPort:    input.Receiver.Port,
 		Format:        string(input.Receiver.HTTP.Format),
-	}, id
+		Codec:         "json",
+	}
+	switch input.Receiver.HTTP.Format {
+	case obs.HTTPReceiverFormatNDJSON:
+		receiver.Framing = "newline_delimited"
+	case obs.HTTPReceiverFormatRaw:
+		receiver.Framing = "newline_delimited"
+		receiver.Codec = "bytes"
+	}
+	return receiver, id
 }
 
 type HttpReceiver struct {
@@ -23,6 +35,8 @@ type HttpReceiver struct {
 	ListenAddress string
 	ListenPort    int32
 	Format        string
+	Framing       string
+	Codec         string
 }
 
 func (HttpReceiver) Name() string {
@@ -35,7 +49,10 @@ func (i HttpReceiver) Template() string {
 [sources.{{.ID}}]
 type = "http_server"
 address = "{{.ListenAddress}}:{{.ListenPort}}"
-decoding.codec = "json"
+{{- if .Framing}}
+framing.method = "{{.Framing}}"
+{{- end}}
+decoding.codec = "{{.Codec}}"
 {{end}}
 `
 }
@@ -56,3 +73,57 @@ func NewItemsTransform(id, inputs string) (framework.Element, string) {
 		VRL:         `if exists(.items) {. = .items} else {.}`,
 	}, itemsID
 }
+
+// NewFieldMappingTransform maps the records received in a generic format to the message, timestamp and level of a log
+func NewFieldMappingTransform(id, inputs string, receiver obs.HTTPReceiver) (framework.Element, string) {
+	mappingID := helpers.MakeID(id, "mapping")
+	vrl := []string{
+		`ts = del(.timestamp)`,
+		`del(.source_type)`,
+		`del(.path)`,
+	}
+	if receiver.Format == obs.HTTPReceiverFormatRaw {
+		vrl = append(vrl,
+			`."@timestamp" = ts`,
+			`.level = "default"`,
+		)
+	} else {
+		mappings := obs.HTTPReceiverFieldMappings{}
+		if receiver.FieldMappings != nil {
+			mappings = *receiver.FieldMappings
+		}
+		vrl = append(vrl,
+			`structured = .`,
+			`. = {"structured": structured}`,
+		)
+		if mappings.Message != "" {
+			vrl = append(vrl, fmt.Sprintf(`message = structured%s
+if message != null {
+  .message = to_string(message) ?? encode_json(message)
+} else {
+  .message = encode_json(structured)
+}`, mappings.Message))
+		} else {
+			vrl = append(vrl, `.message = encode_json(structured)`)
+		}
+		if mappings.Timestamp != "" {
+			vrl = append(vrl, fmt.Sprintf(`."@timestamp" = parse_timestamp(to_string(structured%s) ?? "", format: "%%+") ?? ts`, mappings.Timestamp))
+		} else {
+			vrl = append(vrl, `."@timestamp" = ts`)
+		}
+		if mappings.Level != "" {
+			vrl = append(vrl, fmt.Sprintf(`.level = downcase(to_string(structured%s) ?? "")
+if .level == "" {
+  .level = "default"
+}`, mappings.Level))
+		} else {
+			vrl = append(vrl, `.level = "default"`)
+		}
+	}
+	return elements.Remap{
+		Desc:        fmt.Sprintf("Map %s records to log fields", receiver.Format),
+		ComponentID: mappingID,
+		Inputs:      helpers.MakeInputs(inputs),
+		VRL:         strings.Join(vrl, "\n"),
+	}, mappingID
+}
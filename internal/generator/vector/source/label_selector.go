@@ -45,3 +45,39 @@ func matchLabels(matchLabels map[string]string) (results []string) {
 	sort.Strings(results)
 	return results
 }
+
+// NamespaceLabelCondition formats a label selector as a VRL condition on the namespace labels of a log event
+func NamespaceLabelCondition(selector *metav1.LabelSelector) string {
+	if selector == nil {
+		return ""
+	}
+	label := func(key string) string {
+		return fmt.Sprintf(".kubernetes.namespace_labels.%q", key)
+	}
+	values := func(values []string) string {
+		quoted := []string{}
+		for _, v := range values {
+			quoted = append(quoted, fmt.Sprintf("%q", v))
+		}
+		sort.Strings(quoted)
+		return strings.Join(quoted, ", ")
+	}
+	results := []string{}
+	for k, v := range selector.MatchLabels {
+		results = append(results, fmt.Sprintf("%s == %q", label(k), v))
+	}
+	sort.Strings(results)
+	for _, r := range selector.MatchExpressions {
+		switch r.Operator {
+		case metav1.LabelSelectorOpExists:
+			results = append(results, fmt.Sprintf("exists(%s)", label(r.Key)))
+		case metav1.LabelSelectorOpDoesNotExist:
+			results = append(results, fmt.Sprintf("!exists(%s)", label(r.Key)))
+		case metav1.LabelSelectorOpIn:
+			results = append(results, fmt.Sprintf("includes([%s], %s)", values(r.Values), label(r.Key)))
+		case metav1.LabelSelectorOpNotIn:
+			results = append(results, fmt.Sprintf("!includes([%s], %s)", values(r.Values), label(r.Key)))
+		}
+	}
+	return strings.Join(results, " && ")
+}
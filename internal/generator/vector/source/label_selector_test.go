@@ -84,3 +84,23 @@ var _ = DescribeTable("#LabelSelectorFrom", func(s *LabelSelector, exp string) {
 		},
 	}, `key1,key2=value2,!environment,tier in (frontend)`),
 )
+
+var _ = DescribeTable("#NamespaceLabelCondition", func(s *LabelSelector, exp string) {
+	Expect(source.NamespaceLabelCondition(s)).To(Equal(exp))
+},
+	Entry("should be empty for a nil selector", nil, ""),
+	Entry("should match all the defined match labels", &LabelSelector{
+		MatchLabels: map[string]string{
+			"tenant.example.com/id": "a",
+			env:                     prod,
+		},
+	}, `.kubernetes.namespace_labels."environment" == "production" && .kubernetes.namespace_labels."tenant.example.com/id" == "a"`),
+	Entry("should match matchExpressions", &LabelSelector{
+		MatchExpressions: []LabelSelectorRequirement{
+			{Key: env, Operator: In, Values: []string{qa, prod}},
+			{Key: tier, Operator: NotIn, Values: []string{back}},
+			{Key: "team", Operator: Exists},
+			{Key: "legacy", Operator: DoesNotExists},
+		},
+	}, `includes(["production", "qa"], .kubernetes.namespace_labels."environment") && !includes(["backend"], .kubernetes.namespace_labels."tier") && exists(.kubernetes.namespace_labels."team") && !exists(.kubernetes.namespace_labels."legacy")`),
+)
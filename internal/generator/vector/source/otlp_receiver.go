@@ -0,0 +1,106 @@
+package source
+
+import (
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+// OTLPViaqVRL maps OTLP log records into the ViaQ data model as container logs of an application
+const OTLPViaqVRL = `
+.log_source = "container"
+.log_type = "application"
+.openshift.cluster_id = "${OPENSHIFT_CLUSTER_ID:-}"
+resources = object(.resources) ?? {}
+.kubernetes = compact({
+  "namespace_name": resources."k8s.namespace.name",
+  "pod_name": resources."k8s.pod.name",
+  "pod_id": resources."k8s.pod.uid",
+  "container_name": resources."k8s.container.name"
+})
+.hostname = resources."k8s.node.name" || resources."host.name"
+level = downcase(to_string(del(.severity_text)) ?? "")
+if level == "" {
+  level = "default"
+} else if level == "warning" {
+  level = "warn"
+}
+.level = level
+."@timestamp" = del(.timestamp) || del(.observed_timestamp) || now()
+if is_object(.message) {
+  .structured = del(.message)
+}
+.otlp = compact({
+  "attributes": del(.attributes),
+  "resources": del(.resources),
+  "scope": del(.scope),
+  "trace_id": del(.trace_id),
+  "span_id": del(.span_id)
+})
+del(.observed_timestamp)
+del(.severity_number)
+del(.flags)
+del(.dropped_attributes_count)
+del(.source_type)
+`
+
+// NewOTLPSource returns a receiver of OTLP/gRPC and OTLP/HTTP requests and the ID of its log records
+func NewOTLPSource(id, inputName string, input obs.InputSpec, grpcTLS, httpTLS framework.Element) ([]framework.Element, string) {
+	return []framework.Element{
+		OTLPReceiver{
+			ID:            id,
+			InputName:     inputName,
+			ListenAddress: helpers.ListenOnAllLocalInterfacesAddress(),
+			GRPCPort:      observability.OTLPReceiverGRPCPort(*input.Receiver),
+			HTTPPort:      input.Receiver.Port,
+			GRPCTLS:       grpcTLS,
+			HTTPTLS:       httpTLS,
+		},
+	}, helpers.MakeRouteInputID(id, "logs")
+}
+
+// NewOTLPViaqTransform maps OTLP log records into the ViaQ data model
+func NewOTLPViaqTransform(id, inputs string) (framework.Element, string) {
+	viaqID := helpers.MakeID(id, "viaq")
+	return elements.Remap{
+		Desc:        "Map OTLP log records to the ViaQ data model",
+		ComponentID: viaqID,
+		Inputs:      helpers.MakeInputs(inputs),
+		VRL:         strings.TrimSpace(OTLPViaqVRL),
+	}, viaqID
+}
+
+type OTLPReceiver struct {
+	ID            string
+	InputName     string
+	ListenAddress string
+	GRPCPort      int32
+	HTTPPort      int32
+	GRPCTLS       framework.Element
+	HTTPTLS       framework.Element
+}
+
+func (OTLPReceiver) Name() string {
+	return "otlpReceiver"
+}
+
+func (i OTLPReceiver) Template() string {
+	return `
+{{define "` + i.Name() + `" -}}
+[sources.{{.ID}}]
+type = "opentelemetry"
+
+[sources.{{.ID}}.grpc]
+address = "{{.ListenAddress}}:{{.GRPCPort}}"
+{{compose_one .GRPCTLS}}
+
+[sources.{{.ID}}.http]
+address = "{{.ListenAddress}}:{{.HTTPPort}}"
+{{compose_one .HTTPTLS}}
+{{end}}
+`
+}
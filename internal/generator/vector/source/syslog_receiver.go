@@ -1,18 +1,51 @@
 package source
 
 import (
+	"fmt"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 )
 
 func NewSyslogSource(id, inputName string, input obs.InputSpec) framework.Element {
-	return SyslogReceiver{
+	return newSyslogReceiver(id, inputName, input, string(obs.SyslogReceiverProtocolTCP))
+}
+
+// NewUDPSyslogSource returns a receiver of syslog messages over UDP
+func NewUDPSyslogSource(id, inputName string, input obs.InputSpec) framework.Element {
+	return newSyslogReceiver(id, inputName, input, string(obs.SyslogReceiverProtocolUDP))
+}
+
+func newSyslogReceiver(id, inputName string, input obs.InputSpec, mode string) SyslogReceiver {
+	receiver := SyslogReceiver{
 		ID:            id,
 		InputName:     inputName,
 		ListenAddress: helpers.ListenOnAllLocalInterfacesAddress(),
 		ListenPort:    input.Receiver.Port,
+		Mode:          mode,
+	}
+	if input.Receiver.Syslog != nil && input.Receiver.Syslog.MaxMessageSize != nil {
+		receiver.MaxLength = input.Receiver.Syslog.MaxMessageSize.Value()
+	}
+	return receiver
+}
+
+// NewSyslogRFCFilter discards syslog messages that are not formatted using the given RFC.  Only messages
+// formatted using RFC5424 have a version
+func NewSyslogRFCFilter(id string, inputs []string, rfc obs.SyslogRFCType) (framework.Element, string) {
+	filterID := helpers.MakeID(id, "rfc")
+	condition := `!exists(.version)`
+	if rfc == obs.SyslogRFC5424 {
+		condition = `exists(.version)`
 	}
+	return elements.Filter{
+		Desc:        fmt.Sprintf("Discard messages that are not formatted using %s", rfc),
+		ComponentID: filterID,
+		Inputs:      helpers.MakeInputs(inputs...),
+		Condition:   condition,
+	}, filterID
 }
 
 type SyslogReceiver struct {
@@ -20,6 +53,8 @@ type SyslogReceiver struct {
 	InputName     string
 	ListenAddress string
 	ListenPort    int32
+	Mode          string
+	MaxLength     int64
 }
 
 func (SyslogReceiver) Name() string {
@@ -32,7 +67,10 @@ func (i SyslogReceiver) Template() string {
 [sources.{{.ID}}]
 type = "syslog"
 address = "{{.ListenAddress}}:{{.ListenPort}}"
-mode = "tcp"
+mode = "{{.Mode}}"
+{{- if .MaxLength}}
+max_length = {{.MaxLength}}
+{{- end}}
 {{end}}
 `
 }
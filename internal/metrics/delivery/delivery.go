@@ -0,0 +1,218 @@
+// package delivery evaluates the delivery health of the outputs of a collector from the metrics it exposes
+package delivery
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	sentEventsMetric      = "vector_component_sent_events_total"
+	errorsMetric          = "vector_component_errors_total"
+	discardedEventsMetric = "vector_component_discarded_events_total"
+	bufferBytesMetric     = "vector_buffer_byte_size"
+	bufferMaxBytesMetric  = "vector_buffer_max_byte_size"
+	bufferEventsMetric    = "vector_buffer_events"
+	bufferMaxEventsMetric = "vector_buffer_max_event_size"
+
+	componentIDLabel = "component_id"
+	errorTypeLabel   = "error_type"
+
+	// SaturatedBufferRatio is the ratio of the buffer capacity above which the buffer of an output is saturated
+	SaturatedBufferRatio = 0.9
+)
+
+// ComponentMetrics are the delivery metrics of a sink of a collector instance
+type ComponentMetrics struct {
+	SentEvents      float64
+	Errors          float64
+	DiscardedEvents float64
+	BufferBytes     float64
+	BufferMaxBytes  float64
+	BufferEvents    float64
+	BufferMaxEvents float64
+
+	// ErrorTypes are the types of the errors reported by the sink
+	ErrorTypes map[string]float64
+}
+
+// Snapshot maps a component ID to its metrics at a point in time
+type Snapshot map[string]*ComponentMetrics
+
+// CollectorSnapshot maps the UID of each collector pod to its snapshot.  Counters are kept per pod so they are only
+// compared to previous values of the same pod
+type CollectorSnapshot map[string]Snapshot
+
+// Reports returns true if any collector pod reports metrics for the component
+func (s CollectorSnapshot) Reports(id string) bool {
+	for _, snapshot := range s {
+		if snapshot[id] != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Snapshot) component(id string) *ComponentMetrics {
+	if _, found := s[id]; !found {
+		s[id] = &ComponentMetrics{ErrorTypes: map[string]float64{}}
+	}
+	return s[id]
+}
+
+// Parse reads metrics in the prometheus text format and adds them to the snapshot
+func (s Snapshot) Parse(in io.Reader) error {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(in)
+	if err != nil {
+		return err
+	}
+	for name, family := range families {
+		for _, m := range family.GetMetric() {
+			id := label(m, componentIDLabel)
+			if id == "" {
+				continue
+			}
+			c := s.component(id)
+			value := metricValue(m)
+			switch name {
+			case sentEventsMetric:
+				c.SentEvents += value
+			case errorsMetric:
+				c.Errors += value
+				c.ErrorTypes[label(m, errorTypeLabel)] += value
+			case discardedEventsMetric:
+				c.DiscardedEvents += value
+			case bufferBytesMetric:
+				c.BufferBytes += value
+			case bufferMaxBytesMetric:
+				c.BufferMaxBytes += value
+			case bufferEventsMetric:
+				c.BufferEvents += value
+			case bufferMaxEventsMetric:
+				c.BufferMaxEvents += value
+			}
+		}
+	}
+	return nil
+}
+
+func label(m *dto.Metric, name string) string {
+	for _, l := range m.GetLabel() {
+		if l.GetName() == name {
+			return l.GetValue()
+		}
+	}
+	return ""
+}
+
+func metricValue(m *dto.Metric) float64 {
+	switch {
+	case m.Counter != nil:
+		return m.Counter.GetValue()
+	case m.Gauge != nil:
+		return m.Gauge.GetValue()
+	case m.Untyped != nil:
+		return m.Untyped.GetValue()
+	}
+	return 0
+}
+
+// Health is the delivery health of an output between two snapshots.  The message is stable between evaluations with the
+// same outcome so it can be used in a status condition.  The counts are only informational
+type Health struct {
+	Degraded bool
+	Message  string
+
+	Sent        float64
+	Errors      float64
+	Discarded   float64
+	BufferRatio float64
+}
+
+// Evaluate compares the current metrics of a sink of each collector pod to the metrics of the same pod in a previous
+// evaluation.  The sink is degraded when any pod reported errors or discarded events since the previous evaluation or
+// when the buffer of any pod is saturated.  The first evaluation of a pod only establishes its baseline
+func Evaluate(previous, current CollectorSnapshot, id string) Health {
+	var (
+		reported   bool
+		health     Health
+		errorTypes = map[string]bool{}
+	)
+	for pod, snapshot := range current {
+		metrics := snapshot[id]
+		if metrics == nil {
+			continue
+		}
+		reported = true
+		if r := metrics.bufferRatio(); r > health.BufferRatio {
+			health.BufferRatio = r
+		}
+		last := previous[pod][id]
+		if last == nil {
+			continue
+		}
+		health.Sent += increase(last.SentEvents, metrics.SentEvents)
+		health.Errors += increase(last.Errors, metrics.Errors)
+		health.Discarded += increase(last.DiscardedEvents, metrics.DiscardedEvents)
+		for t, v := range metrics.ErrorTypes {
+			if t != "" && increase(last.ErrorTypes[t], v) > 0 {
+				errorTypes[t] = true
+			}
+		}
+	}
+	if !reported {
+		health.Message = "no metrics are reported"
+		return health
+	}
+	var problems []string
+	if health.Errors > 0 {
+		var types []string
+		for t := range errorTypes {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+		problem := "errors are reported"
+		if len(types) > 0 {
+			problem = fmt.Sprintf("%s (error types: %s)", problem, strings.Join(types, ","))
+		}
+		problems = append(problems, problem)
+	}
+	if health.Discarded > 0 {
+		problems = append(problems, "events are discarded")
+	}
+	if health.BufferRatio >= SaturatedBufferRatio {
+		problems = append(problems, fmt.Sprintf("buffer is more than %.0f%% full", SaturatedBufferRatio*100))
+	}
+	if len(problems) > 0 {
+		health.Degraded = true
+		health.Message = strings.Join(problems, ", ")
+		return health
+	}
+	health.Message = "events are delivered without errors"
+	return health
+}
+
+// bufferRatio is the highest ratio of the used buffer capacity by bytes or events
+func (c *ComponentMetrics) bufferRatio() (ratio float64) {
+	if c.BufferMaxBytes > 0 {
+		ratio = c.BufferBytes / c.BufferMaxBytes
+	}
+	if c.BufferMaxEvents > 0 && c.BufferEvents/c.BufferMaxEvents > ratio {
+		ratio = c.BufferEvents / c.BufferMaxEvents
+	}
+	return ratio
+}
+
+// increase is the increase of a counter where a decrease means the counter was reset (e.g. the collector restarted)
+func increase(previous, current float64) float64 {
+	if current < previous {
+		return current
+	}
+	return current - previous
+}
@@ -0,0 +1,185 @@
+package delivery
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("delivery health", func() {
+
+	const (
+		metrics = `
+# TYPE vector_component_sent_events_total counter
+vector_component_sent_events_total{component_id="output_es",component_kind="sink",hostname="node1"} 100
+# TYPE vector_component_errors_total counter
+vector_component_errors_total{component_id="output_es",component_kind="sink",error_type="request_failed",stage="sending"} 2
+vector_component_errors_total{component_id="output_es",component_kind="sink",error_type="encoder_failed",stage="processing"} 1
+# TYPE vector_component_discarded_events_total counter
+vector_component_discarded_events_total{component_id="output_es",component_kind="sink",intentional="false"} 5
+# TYPE vector_buffer_byte_size gauge
+vector_buffer_byte_size{buffer_type="disk",component_id="output_es",component_kind="sink"} 950
+# TYPE vector_buffer_max_byte_size gauge
+vector_buffer_max_byte_size{buffer_type="disk",component_id="output_es",component_kind="sink"} 1000
+# TYPE vector_started_total counter
+vector_started_total 1
+`
+	)
+
+	Context("#Parse", func() {
+		It("should sum the metrics of each component", func() {
+			snapshot := Snapshot{}
+			Expect(snapshot.Parse(strings.NewReader(metrics))).To(Succeed())
+			Expect(snapshot.Parse(strings.NewReader(metrics))).To(Succeed())
+			Expect(snapshot).To(HaveLen(1))
+			Expect(*snapshot["output_es"]).To(Equal(ComponentMetrics{
+				SentEvents:      200,
+				Errors:          6,
+				DiscardedEvents: 10,
+				BufferBytes:     1900,
+				BufferMaxBytes:  2000,
+				ErrorTypes:      map[string]float64{"request_failed": 4, "encoder_failed": 2},
+			}))
+		})
+
+		It("should fail for metrics that are not in the text format", func() {
+			Expect(Snapshot{}.Parse(strings.NewReader("not metrics"))).ToNot(Succeed())
+		})
+	})
+
+	Context("#Evaluate", func() {
+		var (
+			previous CollectorSnapshot
+			pod      = func(metrics *ComponentMetrics) Snapshot {
+				return Snapshot{"output_es": metrics}
+			}
+		)
+		BeforeEach(func() {
+			previous = CollectorSnapshot{
+				"pod1": pod(&ComponentMetrics{
+					SentEvents: 100,
+					Errors:     2,
+					ErrorTypes: map[string]float64{"request_failed": 2},
+				}),
+				"pod2": pod(&ComponentMetrics{
+					SentEvents: 1000,
+					ErrorTypes: map[string]float64{},
+				}),
+			}
+		})
+
+		It("should be delivering when events are sent without errors", func() {
+			current := CollectorSnapshot{
+				"pod1": pod(&ComponentMetrics{SentEvents: 150, Errors: 2, ErrorTypes: map[string]float64{"request_failed": 2}}),
+				"pod2": pod(&ComponentMetrics{SentEvents: 1010, ErrorTypes: map[string]float64{}}),
+			}
+			Expect(Evaluate(previous, current, "output_es")).To(Equal(Health{Message: "events are delivered without errors", Sent: 60}))
+		})
+
+		It("should be degraded when errors are reported since the previous evaluation", func() {
+			current := CollectorSnapshot{
+				"pod1": pod(&ComponentMetrics{SentEvents: 150, Errors: 5, ErrorTypes: map[string]float64{"request_failed": 4, "encoder_failed": 1}}),
+			}
+			Expect(Evaluate(previous, current, "output_es")).To(Equal(Health{Degraded: true, Message: "errors are reported (error types: encoder_failed,request_failed)", Sent: 50, Errors: 3}))
+		})
+
+		It("should be degraded when events are discarded", func() {
+			current := CollectorSnapshot{
+				"pod1": pod(&ComponentMetrics{SentEvents: 150, Errors: 2, DiscardedEvents: 7, ErrorTypes: map[string]float64{}}),
+			}
+			Expect(Evaluate(previous, current, "output_es")).To(Equal(Health{Degraded: true, Message: "events are discarded", Sent: 50, Discarded: 7}))
+		})
+
+		It("should be degraded when the buffer of any pod is saturated", func() {
+			current := CollectorSnapshot{
+				"pod1": pod(&ComponentMetrics{SentEvents: 150, Errors: 2, BufferEvents: 10, BufferMaxEvents: 100, ErrorTypes: map[string]float64{}}),
+				"pod2": pod(&ComponentMetrics{SentEvents: 1000, BufferEvents: 95, BufferMaxEvents: 100, ErrorTypes: map[string]float64{}}),
+			}
+			Expect(Evaluate(previous, current, "output_es")).To(Equal(Health{Degraded: true, Message: "buffer is more than 90% full", Sent: 50, BufferRatio: 0.95}))
+		})
+
+		It("should treat a decreased counter of a pod as reset", func() {
+			current := CollectorSnapshot{
+				"pod1": pod(&ComponentMetrics{SentEvents: 10, ErrorTypes: map[string]float64{}}),
+				"pod2": pod(&ComponentMetrics{SentEvents: 1000, ErrorTypes: map[string]float64{}}),
+			}
+			Expect(Evaluate(previous, current, "output_es")).To(Equal(Health{Message: "events are delivered without errors", Sent: 10}))
+		})
+
+		It("should only establish the baseline of a new pod", func() {
+			current := CollectorSnapshot{
+				"pod1": pod(&ComponentMetrics{SentEvents: 110, Errors: 2, ErrorTypes: map[string]float64{"request_failed": 2}}),
+				"pod3": pod(&ComponentMetrics{SentEvents: 5000, Errors: 40, ErrorTypes: map[string]float64{"request_failed": 40}}),
+			}
+			Expect(Evaluate(previous, current, "output_es")).To(Equal(Health{Message: "events are delivered without errors", Sent: 10}))
+		})
+
+		It("should keep the message stable while the counts change", func() {
+			current := CollectorSnapshot{
+				"pod1": pod(&ComponentMetrics{SentEvents: 150, Errors: 3, ErrorTypes: map[string]float64{"request_failed": 3}}),
+			}
+			next := CollectorSnapshot{
+				"pod1": pod(&ComponentMetrics{SentEvents: 500, Errors: 30, ErrorTypes: map[string]float64{"request_failed": 30}}),
+			}
+			Expect(Evaluate(current, next, "output_es").Message).To(Equal(Evaluate(previous, current, "output_es").Message))
+		})
+
+		It("should report missing metrics", func() {
+			Expect(Evaluate(previous, CollectorSnapshot{"pod1": Snapshot{}}, "output_es")).To(Equal(Health{Message: "no metrics are reported"}))
+		})
+	})
+
+	Context("#ScrapeAll", func() {
+		It("should scrape each pod and skip the pods that fail", func() {
+			ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(metrics))
+			}))
+			defer ok.Close()
+			failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer failing.Close()
+
+			snapshot, errs := ScrapeAll(context.Background(), ok.Client(), []Target{
+				{UID: "pod1", Endpoint: ok.URL},
+				{UID: "pod2", Endpoint: failing.URL},
+			})
+			Expect(errs).To(HaveLen(1))
+			Expect(snapshot).To(HaveLen(1))
+			Expect(snapshot).To(HaveKey("pod1"))
+			Expect(snapshot.Reports("output_es")).To(BeTrue())
+		})
+
+		It("should not scrape pods after the deadline", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			snapshot, errs := ScrapeAll(ctx, http.DefaultClient, []Target{{UID: "pod1", Endpoint: "http://127.0.0.1:1/metrics"}})
+			Expect(errs).To(HaveLen(1))
+			Expect(snapshot).To(BeEmpty())
+		})
+	})
+
+	Context("#Store", func() {
+		It("should return the previous snapshot of a collector", func() {
+			store := NewStore()
+			first := CollectorSnapshot{"pod1": {"output_es": {SentEvents: 1}}}
+			Expect(store.Swap("ns/name", first)).To(BeNil())
+			Expect(store.Swap("ns/name", CollectorSnapshot{})).To(Equal(first))
+			store.Delete("ns/name")
+			Expect(store.Swap("ns/name", CollectorSnapshot{})).To(BeNil())
+		})
+
+		It("should be due once the snapshot of a collector is as old as the interval", func() {
+			store := NewStore()
+			Expect(store.Due("ns/name", time.Hour)).To(BeTrue())
+			store.Swap("ns/name", CollectorSnapshot{})
+			Expect(store.Due("ns/name", time.Hour)).To(BeFalse())
+			Expect(store.Due("ns/name", 0)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,141 @@
+package delivery
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// ServiceCAFile is the CA bundle that signs the serving certificates of services
+	ServiceCAFile = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+
+	scrapeTimeout = 10 * time.Second
+
+	// maxConcurrentScrapes is the maximum number of collector pods scraped at the same time
+	maxConcurrentScrapes = 10
+)
+
+// NewClient returns a client for scraping the metrics endpoints of collector pods.  The pods serve the certificate of
+// the metrics service so serverName is the DNS name of the service instead of the address of the pod
+func NewClient(serverName string) *http.Client {
+	tlsConfig := &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if ca, err := os.ReadFile(ServiceCAFile); err == nil {
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM(ca)
+		tlsConfig.RootCAs = pool
+	}
+	return &http.Client{
+		Timeout: scrapeTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+		},
+	}
+}
+
+// Target is the metrics endpoint of a collector pod
+type Target struct {
+	UID      string
+	Endpoint string
+}
+
+// ScrapeAll fetches the metrics of the targets concurrently until the context is done.  Targets that fail or are not
+// scraped before the context is done are left out of the snapshot and their errors are returned
+func ScrapeAll(ctx context.Context, client *http.Client, targets []Target) (CollectorSnapshot, []error) {
+	var (
+		lock     sync.Mutex
+		wg       sync.WaitGroup
+		snapshot = CollectorSnapshot{}
+		errs     []error
+		slots    = make(chan struct{}, maxConcurrentScrapes)
+	)
+	for _, target := range targets {
+		wg.Add(1)
+		go func(target Target) {
+			defer wg.Done()
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				lock.Lock()
+				defer lock.Unlock()
+				errs = append(errs, fmt.Errorf("not scraped %s: %w", target.Endpoint, ctx.Err()))
+				return
+			}
+			pod := Snapshot{}
+			err := pod.Scrape(ctx, client, target.Endpoint)
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			snapshot[target.UID] = pod
+		}(target)
+	}
+	wg.Wait()
+	return snapshot, errs
+}
+
+// Scrape fetches the metrics from an endpoint and adds them to the snapshot
+func (s Snapshot) Scrape(ctx context.Context, client *http.Client, endpoint string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status scraping %s: %s", endpoint, resp.Status)
+	}
+	return s.Parse(resp.Body)
+}
+
+// Store keeps the latest snapshot of each collector to evaluate the delivery health between evaluations
+type Store struct {
+	lock      sync.Mutex
+	snapshots map[string]CollectorSnapshot
+	taken     map[string]time.Time
+}
+
+// NewStore returns an empty store
+func NewStore() *Store {
+	return &Store{snapshots: map[string]CollectorSnapshot{}, taken: map[string]time.Time{}}
+}
+
+// Swap stores the current snapshot of a collector and returns the previous one
+func (s *Store) Swap(key string, current CollectorSnapshot) CollectorSnapshot {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	previous := s.snapshots[key]
+	s.snapshots[key] = current
+	s.taken[key] = time.Now()
+	return previous
+}
+
+// Due returns true when no snapshot of a collector is stored or the stored snapshot is at least as old as the interval
+func (s *Store) Due(key string, interval time.Duration) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	taken, found := s.taken[key]
+	return !found || time.Since(taken) >= interval
+}
+
+// Delete removes the snapshot of a collector
+func (s *Store) Delete(key string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.snapshots, key)
+	delete(s.taken, key)
+}
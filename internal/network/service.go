@@ -47,22 +47,14 @@ func ReconcileService(k8sClient client.Client, namespace, name, instanceName, co
 	return reconcile.Service(k8sClient, desired)
 }
 
-func ReconcileInputService(k8sClient client.Client, namespace, name, instance, certSecretName string, port, targetPort int32, receiverType obs.ReceiverType, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+// ReconcileInputService reconciles the service that exposes the ports of a receiver input
+func ReconcileInputService(k8sClient client.Client, namespace, name, instance, certSecretName string, ports []v1.ServicePort, receiverType obs.ReceiverType, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
 	desired := factory.NewService(
 		name,
 		namespace,
 		constants.CollectorName,
 		instance,
-		[]v1.ServicePort{
-			{
-				Port: port,
-				TargetPort: intstr.IntOrString{
-					Type:   intstr.Int,
-					IntVal: targetPort,
-				},
-				Protocol: v1.ProtocolTCP,
-			},
-		},
+		ports,
 		withServiceTypeLabel(constants.ServiceTypeInput),
 		visitors,
 	)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path"
 	"reflect"
 	"strings"
 
@@ -32,6 +33,7 @@ var (
 var COMPONENT_IMAGES = map[string]string{
 	constants.VectorName:                 constants.VectorImageEnvVar,
 	constants.LogfilesmetricexporterName: constants.LogfilesmetricImageEnvVar,
+	constants.EventWatcherName:           constants.EventWatcherImageEnvVar,
 }
 
 func AsOwner(o runtime.Object) metav1.OwnerReference {
@@ -178,6 +180,16 @@ func RemoveString(slice []string, s string) (result []string) {
 	return
 }
 
+// MatchesAnyGlob returns true if the value matches any of the glob patterns (e.g. openshift-*)
+func MatchesAnyGlob(value string, patterns ...string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, value); matched {
+			return true
+		}
+	}
+	return false
+}
+
 func PodVolumeEquivalent(lhs, rhs []v1.Volume) bool {
 
 	if len(lhs) != len(rhs) {
@@ -196,3 +196,15 @@ var _ = Describe("TestOwnership", func() {
 		Expect(HasSameOwner(owner1, owner2)).To(BeFalse())
 	})
 })
+
+var _ = Describe("MatchesAnyGlob", func() {
+	It("should return true when the value matches any of the patterns", func() {
+		Expect(MatchesAnyGlob("platform-auth", "istio-system", "platform-*")).To(BeTrue())
+		Expect(MatchesAnyGlob("istio-system", "istio-system")).To(BeTrue())
+	})
+
+	It("should return false when the value matches none of the patterns", func() {
+		Expect(MatchesAnyGlob("my-app", "istio-system", "platform-*")).To(BeFalse())
+		Expect(MatchesAnyGlob("my-app")).To(BeFalse())
+	})
+})
@@ -1,13 +1,63 @@
 package filters
 
 import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/enrich"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/redact"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Validate(context internalcontext.ForwarderContext) {
 	filterMap := internalobs.FilterMap(context.Forwarder.Spec)
 	for _, filter := range filterMap {
-		internalobs.SetCondition(&context.Forwarder.Status.FilterConditions, ValidateFilter(*filter))
+		condition := ValidateFilter(*filter)
+		if condition.Status == metav1.ConditionTrue {
+			if messages := validateFilterReferences(*filter, context.Secrets, context.ConfigMaps); len(messages) > 0 {
+				condition.Status = metav1.ConditionFalse
+				condition.Reason = obs.ReasonValidationFailure
+				condition.Message = strings.Join(messages, ",")
+			}
+		}
+		internalobs.SetCondition(&context.Forwarder.Status.FilterConditions, condition)
+	}
+}
+
+// validateFilterReferences validates the secret and configmap keys referenced by a filter exist, and the lookup table
+// of an enrich filter has the expected shape
+func validateFilterReferences(filter obs.FilterSpec, secrets map[string]*corev1.Secret, configMaps map[string]*corev1.ConfigMap) []string {
+	var refs []*obs.ValueReference
+	filters := internalobs.Filters([]obs.FilterSpec{filter})
+	for _, ref := range filters.SecretReferences() {
+		refs = append(refs, &obs.ValueReference{Key: ref.Key, SecretName: ref.SecretName})
+	}
+	for _, ref := range filters.ConfigMapReferences() {
+		refs = append(refs, &obs.ValueReference{Key: ref.Key, ConfigMapName: ref.ConfigMapName})
+	}
+	if messages := common.ValidateValueReference(refs, secrets, configMaps); len(messages) > 0 {
+		return messages
+	}
+	if filter.Type == obs.FilterTypeRedact {
+		for _, ref := range filters.SecretReferences() {
+			if err := redact.ValidateSalt(string(secrets[ref.SecretName].Data[ref.Key])); err != nil {
+				return []string{fmt.Sprintf("secret[%s.%s] %v", ref.SecretName, ref.Key, err)}
+			}
+		}
+	}
+	if filter.Type == obs.FilterTypeEnrich {
+		table, err := enrich.ParseTable(filter.EnrichFilterSpec.Format, configMaps[filter.EnrichFilterSpec.Table.ConfigMapName].Data[filter.EnrichFilterSpec.Table.Key])
+		if err == nil {
+			err = table.Validate(*filter.EnrichFilterSpec)
+		}
+		if err != nil {
+			return []string{fmt.Sprintf("configmap[%s.%s] %v", filter.EnrichFilterSpec.Table.ConfigMapName, filter.EnrichFilterSpec.Table.Key, err)}
+		}
 	}
+	return nil
 }
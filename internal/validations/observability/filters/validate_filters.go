@@ -4,9 +4,15 @@ import (
 	"fmt"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/multilineexception"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/mutate"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/redact"
+	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/set"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -14,8 +20,19 @@ var (
 	// Matches dot delimited paths with alphanumeric & `_`. Any other characters added in a segment will require quotes.
 	// Matches `.kubernetes.namespace_name` & `kubernetes."test-label/with slashes"` & `."@timestamp"`
 	pathExpRegex = regexp.MustCompile(`^(\.[a-zA-Z0-9_]+|\."[^"]+")(\.[a-zA-Z0-9_]+|\."[^"]+")*$`)
+
+	// Matches templates of static values and field paths with a default value (e.g. `app-{.kubernetes.namespace_name||"none"}`)
+	templateRegex = regexp.MustCompile(`^(([a-zA-Z0-9-_.\/ :@])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`)
+
+	// Matches Prometheus label names
+	labelNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	// highCardinalityFields have a distinct value for nearly every log record and are not allowed as metric labels
+	highCardinalityFields = set.New[string](".message", `."@timestamp"`, ".timestamp", ".kubernetes.pod_id", ".kubernetes.pod_ip")
 )
 
+const maxMetricLabels = 10
+
 func ValidateFilter(spec obs.FilterSpec) (condition metav1.Condition) {
 
 	var results []string
@@ -24,6 +41,22 @@ func ValidateFilter(spec obs.FilterSpec) (condition metav1.Condition) {
 		results = append(results, validateDropFilter(spec)...)
 	case obs.FilterTypePrune:
 		results = append(results, validatePruneFilter(spec)...)
+	case obs.FilterTypeParse:
+		results = append(results, validateParseFilter(spec)...)
+	case obs.FilterTypeMutate:
+		results = append(results, validateMutateFilter(spec)...)
+	case obs.FilterTypeRedact:
+		results = append(results, validateRedactFilter(spec)...)
+	case obs.FilterTypeDetectMultiline:
+		results = append(results, validateDetectMultilineFilter(spec)...)
+	case obs.FilterTypeEnrich:
+		results = append(results, validateEnrichFilter(spec)...)
+	case obs.FilterTypeSample:
+		results = append(results, validateSampleFilter(spec)...)
+	case obs.FilterTypeThrottle:
+		results = append(results, validateThrottleFilter(spec)...)
+	case obs.FilterTypeMetrics:
+		results = append(results, validateMetricsFilter(spec)...)
 	}
 	condition = internalobs.NewConditionFromPrefix(obs.ConditionTypeValidFilterPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("filter %q is valid", spec.Name))
 	if len(results) > 0 {
@@ -41,7 +74,6 @@ func validateDropFilter(filterSpec obs.FilterSpec) (results []string) {
 		results = append(results, fmt.Sprintf("%q drop filter must have at least one test spec'd", filterSpec.Name))
 	}
 
-	var err error
 	// Validate each test
 	for i, dropTest := range filterSpec.DropTestsSpec {
 		testErrors := []string{}
@@ -50,18 +82,9 @@ func validateDropFilter(filterSpec obs.FilterSpec) (results []string) {
 			if err := validateFieldPath(testCondition.Field); err != "" {
 				testErrors = append(testErrors, err)
 			}
-			// Validate only one of matches/notMatches is defined
-			if testCondition.Matches != "" && testCondition.NotMatches != "" {
-				testErrors = append(testErrors, "only one of matches or notMatches can be defined at once")
-			}
-			// Validate provided regex
-			if testCondition.Matches != "" {
-				_, err = regexp.Compile(testCondition.Matches)
-			} else if testCondition.NotMatches != "" {
-				_, err = regexp.Compile(testCondition.Matches)
-			}
-			if err != nil {
-				testErrors = append(testErrors, "matches/notMatches must be a valid regular expression.")
+			// Validate the condition compiles to a VRL expression
+			if _, err := drop.Condition(testCondition); err != nil {
+				testErrors = append(testErrors, err.Error())
 			}
 		}
 		if len(testErrors) != 0 {
@@ -108,6 +131,312 @@ func validatePruneFilter(filterSpec obs.FilterSpec) (results []string) {
 	return results
 }
 
+// validateParseFilter validates the source and target fields and the parser specific options of a parse filter
+func validateParseFilter(filterSpec obs.FilterSpec) (results []string) {
+	parseSpec := filterSpec.ParseFilterSpec
+	if parseSpec == nil {
+		return results
+	}
+	errList := []string{}
+	for _, fieldPath := range []obs.FieldPath{parseSpec.Source, parseSpec.Target} {
+		if fieldPath == "" {
+			continue
+		}
+		if err := validateFieldPath(fieldPath); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	if parseSpec.Target == ".log_type" || parseSpec.Target == ".message" {
+		errList = append(errList, fmt.Sprintf("%q is a required field and can not be the target of a parse filter", parseSpec.Target))
+	}
+	switch parseSpec.Type {
+	case obs.ParserTypeRegex:
+		if parseSpec.Regex == nil || parseSpec.Regex.Pattern == "" {
+			errList = append(errList, "regex parser must spec a pattern")
+			break
+		}
+		if strings.Contains(parseSpec.Regex.Pattern, "'") {
+			errList = append(errList, "regex pattern can not contain a single quote (')")
+		}
+		if re, err := regexp.Compile(parseSpec.Regex.Pattern); err != nil {
+			errList = append(errList, "regex pattern must be a valid regular expression")
+		} else if !hasNamedCaptureGroup(re) {
+			errList = append(errList, "regex pattern must define at least one named capture group")
+		}
+	case obs.ParserTypeCSV:
+		if parseSpec.CSV == nil || len(parseSpec.CSV.Headers) == 0 {
+			errList = append(errList, "csv parser must spec at least one header")
+			break
+		}
+		headers := set.New[string]()
+		for _, h := range parseSpec.CSV.Headers {
+			if h == "" || headers.Has(h) {
+				errList = append(errList, "csv parser headers must be unique and not empty")
+				break
+			}
+			headers.Insert(h)
+		}
+		if len(parseSpec.CSV.Delimiter) > 1 {
+			errList = append(errList, "csv parser delimiter must be a single character")
+		}
+	case obs.ParserTypeGrok:
+		if parseSpec.Grok == nil || parseSpec.Grok.Pattern == "" {
+			errList = append(errList, "grok parser must spec a pattern")
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateMutateFilter validates the field paths of each operation of a mutate filter and that the operations
+// do not remove or modify the fields reserved by the collector and required by outputs
+func validateMutateFilter(filterSpec obs.FilterSpec) (results []string) {
+	if len(filterSpec.MutateFilterSpec) == 0 {
+		return []string{fmt.Sprintf("%q mutate filter must have at least one operation spec'd", filterSpec.Name)}
+	}
+	requiredFields := set.New[obs.FieldPath](".log_type", ".log_source", ".message")
+	routingFields := set.New[obs.FieldPath](".log_type", ".log_source")
+	for i, op := range filterSpec.MutateFilterSpec {
+		errList := []string{}
+		for _, fieldPath := range []obs.FieldPath{op.Field, op.Target} {
+			if fieldPath == "" {
+				continue
+			}
+			if err := validateFieldPath(fieldPath); err != "" {
+				errList = append(errList, err)
+			}
+			if fieldPath == "._internal" || strings.HasPrefix(string(fieldPath), "._internal.") {
+				errList = append(errList, fmt.Sprintf("%q is reserved by the collector", fieldPath))
+			}
+		}
+		switch op.Type {
+		case obs.MutateOperationDelete, obs.MutateOperationRename:
+			if requiredFields.Has(op.Field) {
+				errList = append(errList, fmt.Sprintf("%q is a required field and can not be removed", op.Field))
+			}
+		case obs.MutateOperationSet, obs.MutateOperationLowercase, obs.MutateOperationUppercase, obs.MutateOperationCoerce:
+			if routingFields.Has(op.Field) {
+				errList = append(errList, fmt.Sprintf("%q is a required field and can not be modified", op.Field))
+			}
+		}
+		if routingFields.Has(op.Target) {
+			errList = append(errList, fmt.Sprintf("%q is a required field and can not be modified", op.Target))
+		}
+		if _, err := mutate.Operation(op); err != nil {
+			errList = append(errList, err.Error())
+		}
+		if len(errList) != 0 {
+			results = append(results, fmt.Sprintf("%s: operation[%d] %v", filterSpec.Name, i, errList))
+		}
+	}
+	return results
+}
+
+// validateRedactFilter validates the fields and patterns of a redact filter
+func validateRedactFilter(filterSpec obs.FilterSpec) (results []string) {
+	redactSpec := filterSpec.RedactFilterSpec
+	if redactSpec == nil || (len(redactSpec.Detectors) == 0 && len(redactSpec.Patterns) == 0) {
+		return []string{fmt.Sprintf("%q redact filter must have at least one detector or pattern spec'd", filterSpec.Name)}
+	}
+	errList := []string{}
+	for _, fieldPath := range redactSpec.Fields {
+		if err := validateFieldPath(fieldPath); err != "" {
+			errList = append(errList, err)
+		}
+		if fieldPath == ".log_type" || fieldPath == ".log_source" {
+			errList = append(errList, fmt.Sprintf("%q is a required field and can not be redacted", fieldPath))
+		}
+	}
+	for _, pattern := range redactSpec.Patterns {
+		if err := redact.ValidatePattern(pattern); err != nil {
+			errList = append(errList, err.Error())
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateDetectMultilineFilter validates the patterns of the custom rules of a detectMultilineException filter
+func validateDetectMultilineFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.DetectMultilineExceptionSpec == nil {
+		return nil
+	}
+	errList := []string{}
+	for i, rule := range filterSpec.DetectMultilineExceptionSpec.Rules {
+		if _, err := multilineexception.NewRule(filterSpec.Name, rule, 0, 0); err != nil {
+			errList = append(errList, fmt.Sprintf("rules[%d] %v", i, err))
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateEnrichFilter validates the columns and target of an enrich filter
+func validateEnrichFilter(filterSpec obs.FilterSpec) (results []string) {
+	enrichSpec := filterSpec.EnrichFilterSpec
+	if enrichSpec == nil {
+		return []string{fmt.Sprintf("%q enrich filter must have a table spec'd", filterSpec.Name)}
+	}
+	errList := []string{}
+	for _, column := range append([]string{enrichSpec.KeyColumn}, enrichSpec.Columns...) {
+		if strings.ContainsAny(column, `"\`) {
+			errList = append(errList, fmt.Sprintf("column %q can not contain a double quote or backslash", column))
+		}
+	}
+	if enrichSpec.Target != "" {
+		if err := validateFieldPath(enrichSpec.Target); err != "" {
+			errList = append(errList, err)
+		}
+		if enrichSpec.Target == ".log_type" || enrichSpec.Target == ".log_source" || strings.HasPrefix(string(enrichSpec.Target), "._internal") {
+			errList = append(errList, fmt.Sprintf("%q can not be the target", enrichSpec.Target))
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateSampleFilter validates the rate, key field and exclusion tests of a sample filter
+func validateSampleFilter(filterSpec obs.FilterSpec) (results []string) {
+	sampleSpec := filterSpec.SampleFilterSpec
+	if sampleSpec == nil {
+		return []string{fmt.Sprintf("%q sample filter must have a rate spec'd", filterSpec.Name)}
+	}
+	errList := []string{}
+	if sampleSpec.Rate < 1 {
+		errList = append(errList, "rate must be greater than zero")
+	}
+	if sampleSpec.KeyField != "" {
+		if err := validateFieldPath(sampleSpec.KeyField); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	for i, test := range sampleSpec.Exclude {
+		for _, testCondition := range test.DropConditions {
+			if err := validateFieldPath(testCondition.Field); err != "" {
+				errList = append(errList, fmt.Sprintf("exclude[%d] %s", i, err))
+			}
+			if _, err := drop.Condition(testCondition); err != nil {
+				errList = append(errList, fmt.Sprintf("exclude[%d] %v", i, err))
+			}
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateThrottleFilter validates the threshold and window of a throttle filter
+func validateThrottleFilter(filterSpec obs.FilterSpec) (results []string) {
+	throttleSpec := filterSpec.ThrottleFilterSpec
+	if throttleSpec == nil {
+		return []string{fmt.Sprintf("%q throttle filter must have a threshold spec'd", filterSpec.Name)}
+	}
+	errList := []string{}
+	if throttleSpec.Threshold < 1 {
+		errList = append(errList, "threshold must be greater than zero")
+	}
+	if throttleSpec.WindowSeconds < 0 {
+		errList = append(errList, "windowSeconds must be greater than zero")
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateMetricsFilter validates the name, field, labels, cardinality limit and match tests of a metrics filter
+func validateMetricsFilter(filterSpec obs.FilterSpec) (results []string) {
+	metricsSpec := filterSpec.MetricsFilterSpec
+	if metricsSpec == nil {
+		return []string{fmt.Sprintf("%q metrics filter must have a metric spec'd", filterSpec.Name)}
+	}
+	errList := []string{}
+	if !labelNameRegex.MatchString(metricsSpec.Name) {
+		errList = append(errList, fmt.Sprintf("name %q must be a valid metric name", metricsSpec.Name))
+	}
+	if metricsSpec.Type == obs.LogMetricTypeHistogram {
+		if metricsSpec.Field == "" {
+			errList = append(errList, "field is required for histograms")
+		} else if err := validateFieldPath(metricsSpec.Field); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	if len(metricsSpec.Labels) > maxMetricLabels {
+		errList = append(errList, fmt.Sprintf("must not have more than %d labels", maxMetricLabels))
+	}
+	for _, name := range sortedKeys(metricsSpec.Labels) {
+		value := metricsSpec.Labels[name]
+		if !labelNameRegex.MatchString(name) {
+			errList = append(errList, fmt.Sprintf("label %q must be a valid label name", name))
+		}
+		if !templateRegex.MatchString(value) {
+			errList = append(errList, fmt.Sprintf("label %q value %q must be a valid template", name, value))
+			continue
+		}
+		for _, field := range templateFields(value) {
+			if highCardinalityFields.Has(field) {
+				errList = append(errList, fmt.Sprintf("label %q must not use the high cardinality field %s", name, field))
+			}
+		}
+	}
+	if metricsSpec.MaxLabelValues < 0 || metricsSpec.MaxLabelValues > 1000 {
+		errList = append(errList, "maxLabelValues must be between 1 and 1000")
+	}
+	for i, test := range metricsSpec.Match {
+		for _, testCondition := range test.DropConditions {
+			if err := validateFieldPath(testCondition.Field); err != "" {
+				errList = append(errList, fmt.Sprintf("match[%d] %s", i, err))
+			}
+			if _, err := drop.Condition(testCondition); err != nil {
+				errList = append(errList, fmt.Sprintf("match[%d] %v", i, err))
+			}
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// templateFields returns the field paths referenced by a template
+func templateFields(template string) (fields []string) {
+	for _, match := range commontemplate.PathRegex.FindAllStringSubmatch(template, -1) {
+		for _, path := range strings.Split(match[1], "||") {
+			if strings.HasPrefix(path, ".") {
+				fields = append(fields, path)
+			}
+		}
+	}
+	return fields
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func hasNamedCaptureGroup(re *regexp.Regexp) bool {
+	for _, name := range re.SubexpNames() {
+		if name != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // validateFieldPath validates a field path for correctness
 func validateFieldPath(fieldPath obs.FieldPath) string {
 	path := string(fieldPath)
@@ -5,13 +5,25 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 var _ = Describe("[internal][validations][observability][filters]", func() {
 	const (
 		myDrop             = "dropFilter"
 		myPrune            = "pruneFilter"
+		myParse            = "parseFilter"
+		myMutate           = "mutateFilter"
+		myRedact           = "redactFilter"
+		mySample           = "sampleFilter"
+		myMultiline        = "multilineFilter"
+		myThrottle         = "throttleFilter"
+		myEnrich           = "enrichFilter"
+		myMetrics          = "metricsFilter"
 		expConditionTypeRE = obs.ConditionTypeValidFilterPrefix + "-.*"
 	)
 
@@ -96,6 +108,46 @@ var _ = Describe("[internal][validations][observability][filters]", func() {
 				},
 				"[matches/notMatches must be a valid regular expression.]",
 			),
+			Entry("should fail validation if more than one operator is spec'd for one condition",
+				[]obs.DropTest{
+					{
+						DropConditions: []obs.DropCondition{
+							{
+								Field:    ".status",
+								Equals:   utils.GetPtr("200"),
+								LessThan: utils.GetPtr(resource.MustParse("400")),
+							},
+						},
+					},
+				},
+				"only one of matches, notMatches, exists, equals, in, greaterThan, lessThan or levelBelow",
+			),
+			Entry("should fail validation if a regular expression contains literal text escapes unsupported by VRL",
+				[]obs.DropTest{
+					{
+						DropConditions: []obs.DropCondition{
+							{
+								Field:   ".message",
+								Matches: `\Q1+1\E`,
+							},
+						},
+					},
+				},
+				`can not contain literal text escapes`,
+			),
+			Entry("should fail validation if a regular expression contains a single quote",
+				[]obs.DropTest{
+					{
+						DropConditions: []obs.DropCondition{
+							{
+								Field:   ".message",
+								Matches: "can't",
+							},
+						},
+					},
+				},
+				`can not contain a single quote \('\)`,
+			),
 		)
 
 		DescribeTable("valid drop filter spec", func(dropTests []obs.DropTest) {
@@ -138,6 +190,35 @@ var _ = Describe("[internal][validations][observability][filters]", func() {
 					},
 				},
 			),
+			Entry("should pass validation when conditions test existence, equality, lists and numbers",
+				[]obs.DropTest{
+					{
+						DropConditions: []obs.DropCondition{
+							{
+								Field:  ".kubernetes.labels.debug",
+								Exists: utils.GetPtr(true),
+							},
+							{
+								Field:           ".level",
+								In:              []string{"Debug", "Trace"},
+								CaseInsensitive: true,
+							},
+						},
+					},
+					{
+						DropConditions: []obs.DropCondition{
+							{
+								Field:  ".log_type",
+								Equals: utils.GetPtr("application"),
+							},
+							{
+								Field:    ".status",
+								LessThan: utils.GetPtr(resource.MustParse("400")),
+							},
+						},
+					},
+				},
+			),
 			Entry("should pass validation when fields are valid path expressions and matches/notMatches are valid regular expressions",
 				[]obs.DropTest{
 					{
@@ -347,4 +428,454 @@ var _ = Describe("[internal][validations][observability][filters]", func() {
 		})
 
 	})
+
+	Context("#validateParseFilter", func() {
+		DescribeTable("invalid parse filter spec", func(parseSpec obs.ParseFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:            myParse,
+				Type:            obs.FilterTypeParse,
+				ParseFilterSpec: &parseSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation if the source is not a valid path expression",
+				obs.ParseFilterSpec{Type: obs.ParserTypeJSON, Source: "message"},
+				"[field must start with a '.']",
+			),
+			Entry("should fail validation if the target is not a valid path expression",
+				obs.ParseFilterSpec{Type: obs.ParserTypeJSON, Target: ".foo-bar"},
+				"[field must be a valid dot delimited path expression+]",
+			),
+			Entry("should fail validation if the target is a required field",
+				obs.ParseFilterSpec{Type: obs.ParserTypeJSON, Target: ".log_type"},
+				"required field and can not be the target",
+			),
+			Entry("should fail validation if the regex parser does not spec a pattern",
+				obs.ParseFilterSpec{Type: obs.ParserTypeRegex},
+				"regex parser must spec a pattern",
+			),
+			Entry("should fail validation if the regex pattern is invalid",
+				obs.ParseFilterSpec{Type: obs.ParserTypeRegex, Regex: &obs.RegexParserSpec{Pattern: "(?P<level>["}},
+				"must be a valid regular expression",
+			),
+			Entry("should fail validation if the regex pattern has no named capture group",
+				obs.ParseFilterSpec{Type: obs.ParserTypeRegex, Regex: &obs.RegexParserSpec{Pattern: `^(\w+): (.*)$`}},
+				"at least one named capture group",
+			),
+			Entry("should fail validation if the regex pattern contains a single quote",
+				obs.ParseFilterSpec{Type: obs.ParserTypeRegex, Regex: &obs.RegexParserSpec{Pattern: `^'(?P<msg>.*)'$`}},
+				"can not contain a single quote",
+			),
+			Entry("should fail validation if the csv parser does not spec headers",
+				obs.ParseFilterSpec{Type: obs.ParserTypeCSV, CSV: &obs.CSVParserSpec{}},
+				"at least one header",
+			),
+			Entry("should fail validation if the csv parser headers are not unique",
+				obs.ParseFilterSpec{Type: obs.ParserTypeCSV, CSV: &obs.CSVParserSpec{Headers: []string{"a", "b", "a"}}},
+				"headers must be unique and not empty",
+			),
+			Entry("should fail validation if the grok parser does not spec a pattern",
+				obs.ParseFilterSpec{Type: obs.ParserTypeGrok, Grok: &obs.GrokParserSpec{}},
+				"grok parser must spec a pattern",
+			),
+		)
+
+		DescribeTable("valid parse filter spec", func(parseSpec *obs.ParseFilterSpec) {
+			spec := obs.FilterSpec{
+				Name:            myParse,
+				Type:            obs.FilterTypeParse,
+				ParseFilterSpec: parseSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		},
+			Entry("should pass validation without a parse filter spec", nil),
+			Entry("should pass validation for a json parser with source and target", &obs.ParseFilterSpec{Type: obs.ParserTypeJSON, Source: ".message", Target: `.kubernetes."parsed-message"`}),
+			Entry("should pass validation for a regex parser with named capture groups", &obs.ParseFilterSpec{Type: obs.ParserTypeRegex, Regex: &obs.RegexParserSpec{Pattern: `^(?P<level>\w+): (?P<msg>.*)$`}}),
+			Entry("should pass validation for a csv parser with headers", &obs.ParseFilterSpec{Type: obs.ParserTypeCSV, CSV: &obs.CSVParserSpec{Headers: []string{"a", "b"}}}),
+			Entry("should pass validation for a grok parser with a pattern", &obs.ParseFilterSpec{Type: obs.ParserTypeGrok, Grok: &obs.GrokParserSpec{Pattern: "%{GREEDYDATA:msg}"}}),
+		)
+	})
+
+	Context("#validateMutateFilter", func() {
+		DescribeTable("invalid mutate filter spec", func(op obs.MutateOperation, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:             myMutate,
+				Type:             obs.FilterTypeMutate,
+				MutateFilterSpec: []obs.MutateOperation{op},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation if the field is not a valid path expression",
+				obs.MutateOperation{Type: obs.MutateOperationDelete, Field: "foo"},
+				"must start with a '.'",
+			),
+			Entry("should fail validation if the target is not a valid path expression",
+				obs.MutateOperation{Type: obs.MutateOperationCopy, Field: ".foo", Target: ".foo-bar"},
+				"must be a valid dot delimited path expression",
+			),
+			Entry("should fail validation if a required field is deleted",
+				obs.MutateOperation{Type: obs.MutateOperationDelete, Field: ".message"},
+				"required field and can not be removed",
+			),
+			Entry("should fail validation if a required field is renamed",
+				obs.MutateOperation{Type: obs.MutateOperationRename, Field: ".log_type", Target: ".type"},
+				"required field and can not be removed",
+			),
+			Entry("should fail validation if a required field is modified",
+				obs.MutateOperation{Type: obs.MutateOperationSet, Field: ".log_source", Value: "custom"},
+				"required field and can not be modified",
+			),
+			Entry("should fail validation if a required field is the target",
+				obs.MutateOperation{Type: obs.MutateOperationCopy, Field: ".foo", Target: ".log_type"},
+				"required field and can not be modified",
+			),
+			Entry("should fail validation if an internal field is mutated",
+				obs.MutateOperation{Type: obs.MutateOperationDelete, Field: "._internal.message"},
+				"reserved by the collector",
+			),
+			Entry("should fail validation if a rename does not spec a target",
+				obs.MutateOperation{Type: obs.MutateOperationRename, Field: ".foo"},
+				"requires a target",
+			),
+		)
+
+		It("should fail if no operations are spec'd", func() {
+			spec := obs.FilterSpec{
+				Name: myMutate,
+				Type: obs.FilterTypeMutate,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "at least one operation"))
+		})
+
+		It("should pass validation for operations on non-reserved fields", func() {
+			spec := obs.FilterSpec{
+				Name: myMutate,
+				Type: obs.FilterTypeMutate,
+				MutateFilterSpec: []obs.MutateOperation{
+					{Type: obs.MutateOperationRename, Field: ".kubernetes.labels.app", Target: ".service.name"},
+					{Type: obs.MutateOperationSet, Field: ".environment", Value: "production"},
+					{Type: obs.MutateOperationLowercase, Field: ".level"},
+					{Type: obs.MutateOperationCoerce, Field: ".status", CoerceType: obs.MutateCoerceTypeInt},
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		})
+	})
+
+	Context("#validateRedactFilter", func() {
+		DescribeTable("invalid redact filter spec", func(redactSpec *obs.RedactFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:             myRedact,
+				Type:             obs.FilterTypeRedact,
+				RedactFilterSpec: redactSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation if no detectors or patterns are spec'd",
+				&obs.RedactFilterSpec{Fields: []obs.FieldPath{".message"}},
+				"at least one detector or pattern",
+			),
+			Entry("should fail validation if a field is not a valid path expression",
+				&obs.RedactFilterSpec{Detectors: []obs.RedactDetector{obs.RedactDetectorEmail}, Fields: []obs.FieldPath{"message"}},
+				"must start with a '.'",
+			),
+			Entry("should fail validation if a required field is redacted",
+				&obs.RedactFilterSpec{Detectors: []obs.RedactDetector{obs.RedactDetectorEmail}, Fields: []obs.FieldPath{".log_type"}},
+				"required field and can not be redacted",
+			),
+			Entry("should fail validation if a pattern is not a valid regular expression",
+				&obs.RedactFilterSpec{Patterns: []string{"(foo"}},
+				"must be a valid regular expression",
+			),
+		)
+
+		It("should pass validation for detectors and patterns on valid fields", func() {
+			spec := obs.FilterSpec{
+				Name: myRedact,
+				Type: obs.FilterTypeRedact,
+				RedactFilterSpec: &obs.RedactFilterSpec{
+					Detectors: []obs.RedactDetector{obs.RedactDetectorEmail, obs.RedactDetectorCreditCard},
+					Patterns:  []string{`ssn=\d{3}-\d{2}-\d{4}`},
+					Fields:    []obs.FieldPath{".message", `.structured."user-email"`},
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		})
+
+		DescribeTable("#validateFilterReferences", func(salt string, errMsg string) {
+			spec := obs.FilterSpec{
+				Name: myRedact,
+				Type: obs.FilterTypeRedact,
+				RedactFilterSpec: &obs.RedactFilterSpec{
+					Detectors: []obs.RedactDetector{obs.RedactDetectorEmail},
+					Replacement: &obs.RedactReplacement{
+						Type: obs.RedactReplacementHash,
+						Salt: &obs.SecretReference{Key: "salt", SecretName: "redact-salt"},
+					},
+				},
+			}
+			secrets := map[string]*corev1.Secret{
+				"redact-salt": runtime.NewSecret("openshift-logging", "redact-salt", map[string][]byte{"salt": []byte(salt)}),
+			}
+			messages := validateFilterReferences(spec, secrets, nil)
+			if errMsg == "" {
+				Expect(messages).To(BeEmpty())
+			} else {
+				Expect(messages).To(ContainElement(ContainSubstring(errMsg)))
+			}
+		},
+			Entry("should pass a salt", "pepper-1234!", ""),
+			Entry("should fail a salt with a double quote", `pep"per`, "salt can not contain a double quote"),
+			Entry("should fail a salt with a brace", `{{pepper}}`, "salt can not contain a double quote"),
+		)
+	})
+
+	Context("#validateSampleFilter", func() {
+		DescribeTable("invalid sample filter spec", func(sampleSpec *obs.SampleFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:             mySample,
+				Type:             obs.FilterTypeSample,
+				SampleFilterSpec: sampleSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation if no spec is defined", nil, "must have a rate"),
+			Entry("should fail validation if the rate is not positive",
+				&obs.SampleFilterSpec{Rate: 0},
+				"rate must be greater than zero",
+			),
+			Entry("should fail validation if the key field is not a valid path expression",
+				&obs.SampleFilterSpec{Rate: 10, KeyField: "kubernetes.pod_name"},
+				"must start with a '.'",
+			),
+			Entry("should fail validation if an exclude condition is not a valid regular expression",
+				&obs.SampleFilterSpec{Rate: 10, Exclude: []obs.DropTest{
+					{DropConditions: []obs.DropCondition{{Field: ".level", Matches: "(error"}}},
+				}},
+				`exclude\[0\] matches/notMatches must be a valid regular expression`,
+			),
+		)
+
+		It("should pass validation for a rate, key field and exclusions", func() {
+			spec := obs.FilterSpec{
+				Name: mySample,
+				Type: obs.FilterTypeSample,
+				SampleFilterSpec: &obs.SampleFilterSpec{
+					Rate:     10,
+					KeyField: ".kubernetes.pod_name",
+					Exclude: []obs.DropTest{
+						{DropConditions: []obs.DropCondition{{Field: ".level", In: []string{"error", "critical"}}}},
+					},
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		})
+	})
+
+	Context("#validateThrottleFilter", func() {
+		DescribeTable("invalid throttle filter spec", func(throttleSpec *obs.ThrottleFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:               myThrottle,
+				Type:               obs.FilterTypeThrottle,
+				ThrottleFilterSpec: throttleSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation if no spec is defined", nil, "must have a threshold"),
+			Entry("should fail validation if the threshold is not positive",
+				&obs.ThrottleFilterSpec{Threshold: 0},
+				"threshold must be greater than zero",
+			),
+			Entry("should fail validation if the window is negative",
+				&obs.ThrottleFilterSpec{Threshold: 10, WindowSeconds: -1},
+				"windowSeconds must be greater than zero",
+			),
+		)
+
+		It("should pass validation for a keyed threshold", func() {
+			spec := obs.FilterSpec{
+				Name: myThrottle,
+				Type: obs.FilterTypeThrottle,
+				ThrottleFilterSpec: &obs.ThrottleFilterSpec{
+					Key:           `{.kubernetes.namespace_name||"none"}`,
+					Threshold:     100,
+					WindowSeconds: 5,
+					Action:        obs.ThrottleActionTag,
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		})
+	})
+
+	Context("#validateDetectMultilineFilter", func() {
+		DescribeTable("invalid detectMultilineException filter spec", func(rule obs.MultilineRule, errMsg string) {
+			spec := obs.FilterSpec{
+				Name: myMultiline,
+				Type: obs.FilterTypeDetectMultiline,
+				DetectMultilineExceptionSpec: &obs.DetectMultilineExceptionFilterSpec{
+					Rules: []obs.MultilineRule{rule},
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation if the start pattern is not a valid regular expression",
+				obs.MultilineRule{StartPattern: "(SELECT"},
+				`rules\[0\] startPattern: .*valid regular expression`,
+			),
+			Entry("should fail validation if the continuation pattern contains a single quote",
+				obs.MultilineRule{StartPattern: "^SELECT", ContinuationPattern: `^'`},
+				`rules\[0\] continuationPattern: .*single quote`,
+			),
+		)
+
+		It("should pass validation without a spec", func() {
+			spec := obs.FilterSpec{
+				Name: myMultiline,
+				Type: obs.FilterTypeDetectMultiline,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		})
+
+		It("should pass validation for languages and valid rules", func() {
+			spec := obs.FilterSpec{
+				Name: myMultiline,
+				Type: obs.FilterTypeDetectMultiline,
+				DetectMultilineExceptionSpec: &obs.DetectMultilineExceptionFilterSpec{
+					Languages: []obs.MultilineLanguage{obs.MultilineLanguageJava, obs.MultilineLanguageDotNet},
+					Rules:     []obs.MultilineRule{{StartPattern: `^SELECT\b`, ContinuationPattern: `^\s+`}},
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		})
+	})
+
+	Context("#validateEnrichFilter", func() {
+		var enrichSpec obs.EnrichFilterSpec
+
+		BeforeEach(func() {
+			enrichSpec = obs.EnrichFilterSpec{
+				Table:     obs.ConfigMapReference{Key: "teams.csv", ConfigMapName: "teams"},
+				Key:       `{.kubernetes.namespace_name||"none"}`,
+				KeyColumn: "namespace",
+				Columns:   []string{"team", "cost-center"},
+			}
+		})
+
+		DescribeTable("invalid enrich filter spec", func(visit func(spec *obs.EnrichFilterSpec), errMsg string) {
+			visit(&enrichSpec)
+			spec := obs.FilterSpec{
+				Name:             myEnrich,
+				Type:             obs.FilterTypeEnrich,
+				EnrichFilterSpec: &enrichSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation if a column contains a double quote", func(spec *obs.EnrichFilterSpec) {
+				spec.Columns = []string{`"team"`}
+			}, "can not contain a double quote"),
+			Entry("should fail validation if the target is not a valid path expression", func(spec *obs.EnrichFilterSpec) {
+				spec.Target = "enrichment"
+			}, "must start with a '.'"),
+			Entry("should fail validation if the target is a required field", func(spec *obs.EnrichFilterSpec) {
+				spec.Target = ".log_type"
+			}, "can not be the target"),
+		)
+
+		It("should pass validation for valid columns and target", func() {
+			enrichSpec.Target = ".openshift.owner"
+			spec := obs.FilterSpec{
+				Name:             myEnrich,
+				Type:             obs.FilterTypeEnrich,
+				EnrichFilterSpec: &enrichSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		})
+
+		DescribeTable("#validateFilterReferences", func(data string, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:             myEnrich,
+				Type:             obs.FilterTypeEnrich,
+				EnrichFilterSpec: &enrichSpec,
+			}
+			configMaps := map[string]*corev1.ConfigMap{
+				"teams": runtime.NewConfigMap("openshift-logging", "teams", map[string]string{"teams.csv": data}),
+			}
+			messages := validateFilterReferences(spec, nil, configMaps)
+			if errMsg == "" {
+				Expect(messages).To(BeEmpty())
+			} else {
+				Expect(messages).To(ContainElement(ContainSubstring(errMsg)))
+			}
+		},
+			Entry("should pass a table with the key and attached columns", "namespace,team,cost-center\napp-a,red,1234\n", ""),
+			Entry("should fail an empty table", "", "configmap[teams.teams.csv] value is empty"),
+			Entry("should fail a table without an attached column", "namespace,team\napp-a,red\n", `does not have a "cost-center" column`),
+			Entry("should fail a table with duplicate keys", "namespace,team,cost-center\napp-a,red,1\napp-a,blue,2\n", "more than one row"),
+		)
+	})
+
+	Context("#validateMetricsFilter", func() {
+		DescribeTable("invalid metrics filter spec", func(metricsSpec *obs.MetricsFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:              myMetrics,
+				Type:              obs.FilterTypeMetrics,
+				MetricsFilterSpec: metricsSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation if no spec is defined", nil, "must have a metric"),
+			Entry("should fail validation if the name is not a metric name",
+				&obs.MetricsFilterSpec{Name: "error-logs"},
+				"must be a valid metric name",
+			),
+			Entry("should fail validation if a histogram has no field",
+				&obs.MetricsFilterSpec{Name: "duration", Type: obs.LogMetricTypeHistogram},
+				"field is required for histograms",
+			),
+			Entry("should fail validation if a label name is not valid",
+				&obs.MetricsFilterSpec{Name: "logs_total", Labels: map[string]string{"name-space": "app"}},
+				"must be a valid label name",
+			),
+			Entry("should fail validation if a label value is not a template",
+				&obs.MetricsFilterSpec{Name: "logs_total", Labels: map[string]string{"namespace": "{.kubernetes.namespace_name}"}},
+				"must be a valid template",
+			),
+			Entry("should fail validation if a label uses a high cardinality field",
+				&obs.MetricsFilterSpec{Name: "logs_total", Labels: map[string]string{"msg": `{.message||"none"}`}},
+				"high cardinality field .message",
+			),
+			Entry("should fail validation if there are too many labels",
+				&obs.MetricsFilterSpec{Name: "logs_total", Labels: map[string]string{
+					"a": "a", "b": "b", "c": "c", "d": "d", "e": "e", "f": "f", "g": "g", "h": "h", "i": "i", "j": "j", "k": "k",
+				}},
+				"must not have more than 10 labels",
+			),
+			Entry("should fail validation if the label value limit is too high",
+				&obs.MetricsFilterSpec{Name: "logs_total", MaxLabelValues: 5000},
+				"maxLabelValues must be between 1 and 1000",
+			),
+			Entry("should fail validation if a match condition is not valid",
+				&obs.MetricsFilterSpec{Name: "logs_total", Match: []obs.DropTest{
+					{DropConditions: []obs.DropCondition{{Field: "level", Matches: "error"}}},
+				}},
+				"match\\[0\\]",
+			),
+		)
+
+		It("should pass validation for a labeled counter of matching records", func() {
+			spec := obs.FilterSpec{
+				Name: myMetrics,
+				Type: obs.FilterTypeMetrics,
+				MetricsFilterSpec: &obs.MetricsFilterSpec{
+					Name:           "error_logs_total",
+					Labels:         map[string]string{"namespace": `{.kubernetes.namespace_name||"none"}`},
+					MaxLabelValues: 200,
+					Match: []obs.DropTest{
+						{DropConditions: []obs.DropCondition{{Field: ".level", Matches: "error"}}},
+					},
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		})
+	})
 })
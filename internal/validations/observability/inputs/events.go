@@ -0,0 +1,25 @@
+package inputs
+
+import (
+	"fmt"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidateEvents validates an events input. The events of the event watcher are collected from the logs of its pods so
+// they can not be collected by a collector deployed as a Deployment
+func ValidateEvents(spec obs.InputSpec, asDeployment bool) []metav1.Condition {
+	if spec.Type != obs.InputTypeEvents {
+		return nil
+	}
+	if asDeployment {
+		return []metav1.Condition{
+			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure,
+				fmt.Sprintf("input %q of type %q is not supported when the collector is deployed as a Deployment", spec.Name, obs.InputTypeEvents)),
+		}
+	}
+	return []metav1.Condition{
+		NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)),
+	}
+}
@@ -0,0 +1,39 @@
+package inputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("#ValidateEvents", func() {
+
+	var (
+		input              obs.InputSpec
+		expConditionTypeRE = obs.ConditionTypeValidInputPrefix + "-.*"
+	)
+	BeforeEach(func() {
+		input = obs.InputSpec{
+			Name: "myevents",
+			Type: obs.InputTypeEvents,
+			Events: &obs.Events{
+				ExcludeNamespaces: []string{"openshift-*"},
+			},
+		}
+	})
+	It("should skip the validation when not an events type", func() {
+		input.Type = obs.InputTypeApplication
+		Expect(ValidateEvents(input, false)).To(BeEmpty())
+	})
+	It("should pass for an events input without an events spec", func() {
+		input.Events = nil
+		Expect(ValidateEvents(input, false)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+	})
+	It("should pass for a valid events input", func() {
+		Expect(ValidateEvents(input, false)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+	})
+	It("should fail for an events input when the collector is deployed as a Deployment", func() {
+		Expect(ValidateEvents(input, true)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `not supported when the collector is deployed as a Deployment`))
+	})
+})
@@ -40,9 +40,13 @@ func ValidateReceiver(spec obs.InputSpec, secrets map[string]*corev1.Secret, con
 			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s does not specify a format", spec.Name)),
 		}
 	}
+	if spec.Receiver.Type == obs.ReceiverTypeOTLP && OTLPReceiverGRPCPort(*spec.Receiver) == spec.Receiver.Port {
+		return []metav1.Condition{
+			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s must spec different ports for OTLP/gRPC and OTLP/HTTP", spec.Name)),
+		}
+	}
 	if spec.Receiver.TLS != nil {
-		tlsSpec := obs.TLSSpec(*spec.Receiver.TLS)
-		keys := ValueReferences(tlsSpec)
+		keys := ValueReferences(*ReceiverTLS(*spec.Receiver))
 		skipKeys := extractSecretKeysAsSet(context)
 		keys = removeGeneratedSecrets(keys, skipKeys)
 		if messages := common.ValidateValueReference(keys, secrets, configMaps); len(messages) > 0 {
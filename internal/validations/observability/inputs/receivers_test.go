@@ -62,6 +62,18 @@ var _ = Describe("#ValidateReceiver", func() {
 			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
 			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
 		})
+		It("should pass for a valid OTLP receiver spec", func() {
+			spec.Receiver.Type = obs.ReceiverTypeOTLP
+			spec.Receiver.Port = 4318
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should fail when an OTLP receiver spec uses the same port for gRPC and HTTP", func() {
+			spec.Receiver.Type = obs.ReceiverTypeOTLP
+			spec.Receiver.Port = 4317
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "myreceiver must spec different ports"))
+		})
 		It("should fail validate secrets if spec'd", func() {
 			spec.Receiver.Type = obs.ReceiverTypeSyslog
 			spec.Receiver.TLS = &obs.InputTLSSpec{
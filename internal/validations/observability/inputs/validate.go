@@ -4,11 +4,13 @@ import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Validate(context internalcontext.ForwarderContext) {
 	results := []metav1.Condition{}
+	_, asDeployment := context.Forwarder.Annotations[constants.AnnotationEnableCollectorAsDeployment]
 	for _, i := range context.Forwarder.Spec.Inputs {
 		var conditions []metav1.Condition
 		switch i.Type {
@@ -20,6 +22,8 @@ func Validate(context internalcontext.ForwarderContext) {
 			conditions = ValidateAudit(i)
 		case obs.InputTypeReceiver:
 			conditions = ValidateReceiver(i, context.Secrets, context.ConfigMaps, context.AdditionalContext)
+		case obs.InputTypeEvents:
+			conditions = ValidateEvents(i, asDeployment)
 		}
 		results = append(results, conditions...)
 	}
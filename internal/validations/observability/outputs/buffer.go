@@ -0,0 +1,44 @@
+package outputs
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// validateBuffer validates the disk buffer of an output
+func validateBuffer(spec obs.OutputSpec) (results []string) {
+	tuning := internalobs.NewTuning(spec)
+	if tuning.Buffer != nil && tuning.Buffer.Type == obs.BufferTypeDisk && tuning.Buffer.MaxSize != nil &&
+		tuning.Buffer.MaxSize.Value() < internalobs.MinDiskBufferSize {
+		results = append(results, fmt.Sprintf("buffer maxSize must be at least %d bytes", internalobs.MinDiskBufferSize))
+	}
+	return results
+}
+
+// validateDiskBufferBudget validates the disk buffers of the outputs deployed to the collector fit in the forwarder disk
+// buffer budget of the collector.  The violation is reported once on the first output whose disk buffer exceeds the budget
+func validateDiskBufferBudget(context internalcontext.ForwarderContext) map[string][]string {
+	budget := internalobs.ForwarderDiskBufferBudget(context.Forwarder.Spec.Collector)
+	sizes := internalobs.DiskBufferSizes(context.Forwarder.Spec)
+	var total int64
+	for _, size := range sizes {
+		total += size
+	}
+	if total <= budget.Value() {
+		return nil
+	}
+	var size int64
+	for _, out := range context.Forwarder.Spec.Outputs {
+		if size += sizes[out.Name]; size > budget.Value() {
+			return map[string][]string{
+				out.Name: {fmt.Sprintf("disk buffers of all outputs require %s which exceeds the forwarder disk buffer budget of %s",
+					resource.NewQuantity(total, resource.BinarySI).String(), budget.String())},
+			}
+		}
+	}
+	return nil
+}
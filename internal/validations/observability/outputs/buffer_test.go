@@ -0,0 +1,122 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("validating output buffers", func() {
+	var (
+		newOutput = func(name string, buffer *obs.OutputBufferSpec) obs.OutputSpec {
+			return obs.OutputSpec{
+				Name: name,
+				Type: obs.OutputTypeHTTP,
+				HTTP: &obs.HTTP{
+					Tuning: &obs.HTTPTuningSpec{
+						BaseOutputTuningSpec: obs.BaseOutputTuningSpec{
+							Buffer: buffer,
+						},
+					},
+				},
+			}
+		}
+		diskBuffer = func(size string) *obs.OutputBufferSpec {
+			return &obs.OutputBufferSpec{
+				Type:    obs.BufferTypeDisk,
+				MaxSize: utils.GetPtr(resource.MustParse(size)),
+			}
+		}
+		newContext = func(collector *obs.CollectorSpec, outputs ...obs.OutputSpec) internalcontext.ForwarderContext {
+			pipeline := obs.PipelineSpec{Name: "pipeline"}
+			for _, o := range outputs {
+				pipeline.OutputRefs = append(pipeline.OutputRefs, o.Name)
+			}
+			return internalcontext.ForwarderContext{
+				Forwarder: &obs.ClusterLogForwarder{
+					Spec: obs.ClusterLogForwarderSpec{
+						Collector: collector,
+						Outputs:   outputs,
+						Pipelines: []obs.PipelineSpec{pipeline},
+					},
+				},
+			}
+		}
+	)
+
+	Context("#validateBuffer", func() {
+
+		It("should pass for a memory buffer", func() {
+			out := newOutput("mem", &obs.OutputBufferSpec{Type: obs.BufferTypeMemory, MaxEvents: utils.GetPtr[int64](100)})
+			Expect(validateBuffer(out)).To(BeEmpty())
+		})
+
+		It("should fail when a disk buffer is smaller than the minimum size", func() {
+			out := newOutput("disk", diskBuffer("1Mi"))
+			Expect(validateBuffer(out)).To(ConsistOf(ContainSubstring("buffer maxSize must be at least")))
+		})
+	})
+
+	Context("#validateDiskBufferBudget", func() {
+
+		It("should pass when the disk buffers fit in the default budget", func() {
+			out := newOutput("disk", diskBuffer("5Gi"))
+			other := newOutput("other", diskBuffer("5Gi"))
+			Expect(validateDiskBufferBudget(newContext(nil, out, other))).To(BeEmpty())
+		})
+
+		It("should fail once when the disk buffers of all outputs exceed the spec'd budget", func() {
+			out := newOutput("disk", diskBuffer("1Gi"))
+			other := newOutput("other", diskBuffer("1Gi"))
+			last := newOutput("last", diskBuffer("1Gi"))
+			collector := &obs.CollectorSpec{ForwarderDiskBufferBudget: utils.GetPtr(resource.MustParse("1500Mi"))}
+			Expect(validateDiskBufferBudget(newContext(collector, out, other, last))).To(Equal(map[string][]string{
+				"other": {"disk buffers of all outputs require 3Gi which exceeds the forwarder disk buffer budget of 1500Mi"},
+			}))
+		})
+
+		It("should include the implied disk buffer of atLeastOnce outputs in the budget", func() {
+			out := newOutput("disk", diskBuffer("1Gi"))
+			other := newOutput("other", nil)
+			other.HTTP.Tuning.DeliveryMode = obs.DeliveryModeAtLeastOnce
+			collector := &obs.CollectorSpec{ForwarderDiskBufferBudget: utils.GetPtr(resource.MustParse("1Gi"))}
+			Expect(validateDiskBufferBudget(newContext(collector, out, other))).To(HaveKey("other"))
+		})
+
+		It("should not report the budget on outputs without a disk buffer", func() {
+			mem := newOutput("mem", &obs.OutputBufferSpec{Type: obs.BufferTypeMemory})
+			out := newOutput("disk", diskBuffer("1Gi"))
+			collector := &obs.CollectorSpec{ForwarderDiskBufferBudget: utils.GetPtr(resource.MustParse("512Mi"))}
+			Expect(validateDiskBufferBudget(newContext(collector, mem, out))).To(HaveLen(1))
+			Expect(validateDiskBufferBudget(newContext(collector, mem, out))).To(HaveKey("disk"))
+		})
+
+		It("should not include outputs that are not referenced by a pipeline in the budget", func() {
+			out := newOutput("disk", diskBuffer("1Gi"))
+			unused := newOutput("unused", diskBuffer("1Gi"))
+			collector := &obs.CollectorSpec{ForwarderDiskBufferBudget: utils.GetPtr(resource.MustParse("1Gi"))}
+			context := newContext(collector, out)
+			context.Forwarder.Spec.Outputs = append(context.Forwarder.Spec.Outputs, unused)
+			Expect(validateDiskBufferBudget(context)).To(BeEmpty())
+		})
+
+		It("should include only the largest disk buffer of the outputs of a failover group in the budget", func() {
+			out := newOutput("disk", diskBuffer("1Gi"))
+			primary := newOutput("primary", diskBuffer("1Gi"))
+			secondary := newOutput("secondary", diskBuffer("2Gi"))
+			collector := &obs.CollectorSpec{ForwarderDiskBufferBudget: utils.GetPtr(resource.MustParse("3Gi"))}
+			context := newContext(collector, out)
+			context.Forwarder.Spec.Outputs = append(context.Forwarder.Spec.Outputs, primary, secondary)
+			context.Forwarder.Spec.Pipelines[0].Failover = &obs.FailoverSpec{OutputRefs: []string{"primary", "secondary"}}
+			Expect(validateDiskBufferBudget(context)).To(BeEmpty())
+
+			collector.ForwarderDiskBufferBudget = utils.GetPtr(resource.MustParse("2Gi"))
+			Expect(validateDiskBufferBudget(context)).To(Equal(map[string][]string{
+				"secondary": {"disk buffers of all outputs require 3Gi which exceeds the forwarder disk buffer budget of 2Gi"},
+			}))
+		})
+	})
+})
@@ -14,12 +14,20 @@ const (
 	ErrVariousRoleARNAuth = "Found multiple different CloudWatch RoleARN authorizations in the outputs spec"
 )
 
+// ValidateCloudWatchAuth validates the AWS authentication of cloudwatch and s3 outputs. The web identity of the
+// collector is shared by all outputs so every IAM role must reference the same role ARN
 func ValidateCloudWatchAuth(spec obs.OutputSpec, context internalcontext.ForwarderContext) (results []string) {
 	secrets := observability.Secrets(context.Secrets)
 	additionalContext := context.AdditionalContext
-	authSpec := spec.Cloudwatch.Authentication
+	var authSpec *obs.CloudwatchAuthentication
+	switch {
+	case spec.Type == obs.OutputTypeCloudwatch && spec.Cloudwatch != nil:
+		authSpec = spec.Cloudwatch.Authentication
+	case spec.Type == obs.OutputTypeS3 && spec.S3 != nil:
+		authSpec = spec.S3.Authentication
+	}
 
-	if authSpec.Type == obs.CloudwatchAuthTypeIAMRole {
+	if authSpec != nil && authSpec.Type == obs.CloudwatchAuthTypeIAMRole {
 		roleArn := cloudwatch.ParseRoleArn(authSpec, secrets)
 		roleARNs := set.New(roleArn)
 		utils.Update(additionalContext, RoleARNsOpt, roleARNs, func(existing *set.Set) *set.Set {
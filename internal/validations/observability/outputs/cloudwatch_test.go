@@ -77,5 +77,18 @@ var _ = Describe("validating CloudWatch auth", func() {
 			Expect(res).To(BeEmpty())
 		})
 
+		It("should fail validation if an s3 output meets a different Role ARN", func() {
+			s3Spec := obs.OutputSpec{
+				Name: "s3",
+				Type: obs.OutputTypeS3,
+				S3: &obs.S3{
+					Authentication: spec.Cloudwatch.Authentication,
+				},
+			}
+			context.AdditionalContext = utils.Options{
+				RoleARNsOpt: set.New(otherRoleArn),
+			}
+			Expect(ValidateCloudWatchAuth(s3Spec, context)).To(ConsistOf(ErrVariousRoleARNAuth))
+		})
 	})
 })
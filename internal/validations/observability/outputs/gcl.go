@@ -0,0 +1,70 @@
+package outputs
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var (
+	gclResourceLabels = sets.New[string]("project_id", "location", "cluster_name", "namespace_name", "pod_name", "container_name", "node_name")
+	// gclTemplateRegex matches the templates of the logId of an output
+	gclTemplateRegex = regexp.MustCompile(`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`)
+	// gclServiceAccountEmailRegex matches the email of a Google service account
+	gclServiceAccountEmailRegex = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]@[a-z0-9-]+\.iam\.gserviceaccount\.com$`)
+)
+
+// ValidateGoogleCloudLoggingAuthentication validates the credentials or the workload identity of a Google Cloud Logging output
+func ValidateGoogleCloudLoggingAuthentication(spec obs.OutputSpec) (results []string) {
+	if spec.GoogleCloudLogging == nil || spec.GoogleCloudLogging.Authentication == nil {
+		return nil
+	}
+	a := spec.GoogleCloudLogging.Authentication
+	if (a.Credentials == nil) == (a.WorkloadIdentity == nil) {
+		return append(results, "exactly one of credentials or workloadIdentity is required")
+	}
+	if wi := a.WorkloadIdentity; wi != nil {
+		if wi.ServiceAccountEmail != "" && !gclServiceAccountEmailRegex.MatchString(wi.ServiceAccountEmail) {
+			results = append(results, fmt.Sprintf("workloadIdentity serviceAccountEmail %q is not the email of a Google service account", wi.ServiceAccountEmail))
+		}
+		if wi.Token.From == obs.BearerTokenFromSecret && wi.Token.Secret == nil {
+			results = append(results, "workloadIdentity token requires a secret when it is sourced from a secret")
+		}
+	}
+	return results
+}
+
+// ValidateGoogleCloudLoggingResource validates the monitored resource of a Google Cloud Logging output
+func ValidateGoogleCloudLoggingResource(spec obs.OutputSpec) (results []string) {
+	if spec.GoogleCloudLogging == nil {
+		return nil
+	}
+	g := spec.GoogleCloudLogging
+	r := g.Resource
+	if r == nil {
+		r = &obs.GoogleCloudLoggingResource{Type: obs.GoogleCloudLoggingResourceTypeK8sContainer}
+	}
+	if r.Type != obs.GoogleCloudLoggingResourceTypeK8sNode && r.ProjectId == "" && g.ID.Type != obs.GoogleCloudLoggingIdTypeProject {
+		if _, found := r.Labels["project_id"]; !found {
+			results = append(results, "resource type k8sContainer requires a projectId when the id type is not project")
+		}
+	}
+	names := []string{}
+	for name := range r.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !gclResourceLabels.Has(name) {
+			results = append(results, fmt.Sprintf("resource label %q must be one of %v", name, sets.List(gclResourceLabels)))
+			continue
+		}
+		if !gclTemplateRegex.MatchString(r.Labels[name]) {
+			results = append(results, fmt.Sprintf("resource label %q has an invalid template: %q", name, r.Labels[name]))
+		}
+	}
+	return results
+}
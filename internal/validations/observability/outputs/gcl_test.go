@@ -0,0 +1,123 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("Validating the resource of a Google Cloud Logging output", func() {
+	Context("#ValidateGoogleCloudLoggingResource", func() {
+		var spec obs.OutputSpec
+
+		BeforeEach(func() {
+			spec = obs.OutputSpec{
+				Name: "my-gcl",
+				Type: obs.OutputTypeGoogleCloudLogging,
+				GoogleCloudLogging: &obs.GoogleCloudLogging{
+					ID: obs.GoogleCloudLoggingId{
+						Type:  obs.GoogleCloudLoggingIdTypeProject,
+						Value: "my-project",
+					},
+					LogId: "my-log",
+					Resource: &obs.GoogleCloudLoggingResource{
+						Type:        obs.GoogleCloudLoggingResourceTypeK8sContainer,
+						Location:    "us-central1",
+						ClusterName: "my-cluster",
+						Labels: map[string]string{
+							"namespace_name": `{.kubernetes.labels.tenant||"none"}`,
+						},
+					},
+				},
+			}
+		})
+
+		It("should pass validation for a valid resource", func() {
+			Expect(ValidateGoogleCloudLoggingResource(spec)).To(BeEmpty())
+		})
+		It("should pass validation without a resource", func() {
+			spec.GoogleCloudLogging.Resource = nil
+			Expect(ValidateGoogleCloudLoggingResource(spec)).To(BeEmpty())
+		})
+		It("should fail validation without a resource or a project", func() {
+			spec.GoogleCloudLogging.Resource = nil
+			spec.GoogleCloudLogging.ID.Type = obs.GoogleCloudLoggingIdTypeOrganization
+			Expect(ValidateGoogleCloudLoggingResource(spec)).To(ContainElement(ContainSubstring("requires a projectId")))
+		})
+		It("should pass validation for a node resource without a project", func() {
+			spec.GoogleCloudLogging.ID.Type = obs.GoogleCloudLoggingIdTypeOrganization
+			spec.GoogleCloudLogging.Resource.Type = obs.GoogleCloudLoggingResourceTypeK8sNode
+			Expect(ValidateGoogleCloudLoggingResource(spec)).To(BeEmpty())
+		})
+		It("should fail validation for a container resource without a project", func() {
+			spec.GoogleCloudLogging.ID.Type = obs.GoogleCloudLoggingIdTypeOrganization
+			Expect(ValidateGoogleCloudLoggingResource(spec)).To(ContainElement(ContainSubstring("requires a projectId")))
+		})
+		It("should pass validation for a container resource with a project", func() {
+			spec.GoogleCloudLogging.ID.Type = obs.GoogleCloudLoggingIdTypeOrganization
+			spec.GoogleCloudLogging.Resource.ProjectId = "my-project"
+			Expect(ValidateGoogleCloudLoggingResource(spec)).To(BeEmpty())
+		})
+		It("should fail validation for an unknown label", func() {
+			spec.GoogleCloudLogging.Resource.Labels["zone"] = "us-central1-a"
+			Expect(ValidateGoogleCloudLoggingResource(spec)).To(ConsistOf(ContainSubstring(`resource label "zone" must be one of`)))
+		})
+		It("should fail validation for an invalid template", func() {
+			spec.GoogleCloudLogging.Resource.Labels["pod_name"] = "{.kubernetes.pod_name}"
+			Expect(ValidateGoogleCloudLoggingResource(spec)).To(ConsistOf(ContainSubstring(`resource label "pod_name" has an invalid template`)))
+		})
+	})
+
+	Context("#ValidateGoogleCloudLoggingAuthentication", func() {
+		var spec obs.OutputSpec
+
+		BeforeEach(func() {
+			spec = obs.OutputSpec{
+				Name: "my-gcl",
+				Type: obs.OutputTypeGoogleCloudLogging,
+				GoogleCloudLogging: &obs.GoogleCloudLogging{
+					ID: obs.GoogleCloudLoggingId{
+						Type:  obs.GoogleCloudLoggingIdTypeProject,
+						Value: "my-project",
+					},
+					LogId: "my-log",
+					Authentication: &obs.GoogleCloudLoggingAuthentication{
+						WorkloadIdentity: &obs.GoogleCloudLoggingWorkloadIdentity{
+							Audience:            "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool-1/providers/provider-1",
+							ServiceAccountEmail: "log-writer@my-project.iam.gserviceaccount.com",
+							Token: obs.BearerToken{
+								From: obs.BearerTokenFromServiceAccount,
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should pass validation for a workload identity", func() {
+			Expect(ValidateGoogleCloudLoggingAuthentication(spec)).To(BeEmpty())
+		})
+		It("should pass validation for credentials", func() {
+			spec.GoogleCloudLogging.Authentication = &obs.GoogleCloudLoggingAuthentication{
+				Credentials: &obs.SecretReference{Key: "google-application-credentials.json", SecretName: "gcl"},
+			}
+			Expect(ValidateGoogleCloudLoggingAuthentication(spec)).To(BeEmpty())
+		})
+		It("should fail validation for both credentials and a workload identity", func() {
+			spec.GoogleCloudLogging.Authentication.Credentials = &obs.SecretReference{Key: "google-application-credentials.json", SecretName: "gcl"}
+			Expect(ValidateGoogleCloudLoggingAuthentication(spec)).To(ConsistOf(ContainSubstring("exactly one of credentials or workloadIdentity")))
+		})
+		It("should fail validation without credentials or a workload identity", func() {
+			spec.GoogleCloudLogging.Authentication.WorkloadIdentity = nil
+			Expect(ValidateGoogleCloudLoggingAuthentication(spec)).To(ConsistOf(ContainSubstring("exactly one of credentials or workloadIdentity")))
+		})
+		It("should fail validation for an email that is not a Google service account", func() {
+			spec.GoogleCloudLogging.Authentication.WorkloadIdentity.ServiceAccountEmail = "someone@example.com"
+			Expect(ValidateGoogleCloudLoggingAuthentication(spec)).To(ConsistOf(ContainSubstring("is not the email of a Google service account")))
+		})
+		It("should fail validation for a token from a secret without the secret", func() {
+			spec.GoogleCloudLogging.Authentication.WorkloadIdentity.Token.From = obs.BearerTokenFromSecret
+			Expect(ValidateGoogleCloudLoggingAuthentication(spec)).To(ConsistOf(ContainSubstring("requires a secret")))
+		})
+	})
+})
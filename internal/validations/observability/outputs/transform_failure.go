@@ -0,0 +1,27 @@
+package outputs
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+)
+
+// validateTransformFailure validates the transform failure output of an output exists and does not reroute events itself
+func validateTransformFailure(spec obs.OutputSpec, context internalcontext.ForwarderContext) []string {
+	if spec.TransformFailureOutputRef == "" {
+		return nil
+	}
+	if spec.TransformFailureOutputRef == spec.Name {
+		return []string{"an output can not be its own transform failure output"}
+	}
+	for _, o := range context.Forwarder.Spec.Outputs {
+		if o.Name == spec.TransformFailureOutputRef {
+			if o.TransformFailureOutputRef != "" {
+				return []string{fmt.Sprintf("transform failure output %q must not spec a transform failure output", o.Name)}
+			}
+			return nil
+		}
+	}
+	return []string{fmt.Sprintf("transform failure output %q does not exist", spec.TransformFailureOutputRef)}
+}
@@ -0,0 +1,59 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+)
+
+var _ = Describe("validating transform failure outputs", func() {
+	Context("#validateTransformFailure", func() {
+
+		var (
+			newOutput = func(name, transformFailure string) obs.OutputSpec {
+				return obs.OutputSpec{
+					Name:                      name,
+					Type:                      obs.OutputTypeHTTP,
+					HTTP:                      &obs.HTTP{},
+					TransformFailureOutputRef: transformFailure,
+				}
+			}
+			newContext = func(outputs ...obs.OutputSpec) internalcontext.ForwarderContext {
+				return internalcontext.ForwarderContext{
+					Forwarder: &obs.ClusterLogForwarder{
+						Spec: obs.ClusterLogForwarderSpec{
+							Outputs: outputs,
+						},
+					},
+				}
+			}
+		)
+
+		It("should pass for an output without a transform failure output", func() {
+			out := newOutput("http", "")
+			Expect(validateTransformFailure(out, newContext(out))).To(BeEmpty())
+		})
+
+		It("should pass when the transform failure output exists", func() {
+			out := newOutput("http", "archive")
+			Expect(validateTransformFailure(out, newContext(out, newOutput("archive", "")))).To(BeEmpty())
+		})
+
+		It("should fail when the transform failure output does not exist", func() {
+			out := newOutput("http", "archive")
+			Expect(validateTransformFailure(out, newContext(out))).To(ConsistOf(`transform failure output "archive" does not exist`))
+		})
+
+		It("should fail when the output is its own transform failure output", func() {
+			out := newOutput("http", "http")
+			Expect(validateTransformFailure(out, newContext(out))).To(ConsistOf("an output can not be its own transform failure output"))
+		})
+
+		It("should fail when the transform failure output reroutes events itself", func() {
+			out := newOutput("http", "archive")
+			Expect(validateTransformFailure(out, newContext(out, newOutput("archive", "other"), newOutput("other", "")))).
+				To(ConsistOf(`transform failure output "archive" must not spec a transform failure output`))
+		})
+	})
+})
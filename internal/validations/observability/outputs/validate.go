@@ -10,6 +10,7 @@ import (
 )
 
 func Validate(context internalcontext.ForwarderContext) {
+	budgetResults := validateDiskBufferBudget(context)
 	for _, out := range context.Forwarder.Spec.Outputs {
 		messages := []string{}
 		configs := internalobs.SecretReferencesAsValueReferences(out)
@@ -18,10 +19,16 @@ func Validate(context internalcontext.ForwarderContext) {
 			configs = append(configs, internalobs.ValueReferences(out.TLS.TLSSpec)...)
 		}
 		messages = append(messages, common.ValidateValueReference(configs, context.Secrets, context.ConfigMaps)...)
+		messages = append(messages, validateBuffer(out)...)
+		messages = append(messages, budgetResults[out.Name]...)
+		messages = append(messages, validateTransformFailure(out, context)...)
 		// Validate by output type
 		switch out.Type {
-		case obs.OutputTypeCloudwatch:
+		case obs.OutputTypeCloudwatch, obs.OutputTypeS3:
 			messages = append(messages, ValidateCloudWatchAuth(out, context)...)
+		case obs.OutputTypeGoogleCloudLogging:
+			messages = append(messages, ValidateGoogleCloudLoggingAuthentication(out)...)
+			messages = append(messages, ValidateGoogleCloudLoggingResource(out)...)
 		case obs.OutputTypeHTTP:
 			messages = append(messages, validateHttpContentTypeHeaders(out)...)
 		case obs.OutputTypeOTLP:
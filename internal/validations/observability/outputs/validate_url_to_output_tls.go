@@ -4,31 +4,14 @@ import (
 	"fmt"
 	log "github.com/ViaQ/logerr/v2/log/static"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/url"
 	"strings"
 )
 
 // validateURLAccordingToTLS validate that if Output has TLS configuration Output URL scheme must be secure e.g. https, tls etc
 func validateURLAccordingToTLS(output obs.OutputSpec) (results []string) {
-	specURL := ""
-	switch output.Type {
-	case obs.OutputTypeCloudwatch:
-		specURL = output.Cloudwatch.URL
-	case obs.OutputTypeElasticsearch:
-		specURL = output.Elasticsearch.URL
-	case obs.OutputTypeHTTP:
-		specURL = output.HTTP.URL
-	case obs.OutputTypeKafka:
-		specURL = output.Kafka.URL
-	case obs.OutputTypeLoki:
-		specURL = output.Loki.URL
-	case obs.OutputTypeSplunk:
-		specURL = output.Splunk.URL
-	case obs.OutputTypeSyslog:
-		specURL = output.Syslog.URL
-	case obs.OutputTypeOTLP:
-		specURL = output.OTLP.URL
-	}
+	specURL := internalobs.URL(output)
 
 	// some outputs not require to have output URL (e.g. Amazon CloudWatch or Google Cloud Logging)
 	if specURL != "" && output.TLS != nil {
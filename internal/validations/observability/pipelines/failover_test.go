@@ -0,0 +1,52 @@
+package pipelines
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("Pipeline validation #validateFailover", func() {
+
+	var (
+		outputs = map[string]obs.OutputSpec{
+			"loki":      {Name: "loki", Type: obs.OutputTypeLoki},
+			"s3":        {Name: "s3", Type: obs.OutputTypeS3},
+			"lokistack": {Name: "lokistack", Type: obs.OutputTypeLokiStack},
+		}
+		newPipeline = func(outputRefs []string, failover ...string) obs.PipelineSpec {
+			return obs.PipelineSpec{
+				Name:       "mypipeline",
+				InputRefs:  []string{"application"},
+				OutputRefs: outputRefs,
+				Failover: &obs.FailoverSpec{
+					OutputRefs: failover,
+				},
+			}
+		}
+	)
+
+	It("should pass for a pipeline without a failover group", func() {
+		Expect(validateFailover(obs.PipelineSpec{OutputRefs: []string{"loki"}}, outputs)).To(BeEmpty())
+	})
+
+	It("should pass for a failover group of unique outputs", func() {
+		Expect(validateFailover(newPipeline(nil, "loki", "s3"), outputs)).To(BeEmpty())
+	})
+
+	It("should fail when an output is listed more than once", func() {
+		Expect(validateFailover(newPipeline(nil, "loki", "loki"), outputs)).To(ConsistOf(`output "loki" is listed more than once in the failover group`))
+	})
+
+	It("should fail when an output is referenced by the pipeline outside of the group", func() {
+		Expect(validateFailover(newPipeline([]string{"s3"}, "loki", "s3"), outputs)).To(ConsistOf(`output "s3" can not be referenced by both outputRefs and the failover group`))
+	})
+
+	It("should fail for a lokistack output", func() {
+		Expect(validateFailover(newPipeline(nil, "lokistack", "s3"), outputs)).To(ConsistOf(`output "lokistack" of type "lokiStack" is not supported in a failover group`))
+	})
+
+	It("should fail when an output of the group does not exist", func() {
+		Expect(validateRef(newPipeline(nil, "loki", "missing"), map[string]obs.InputSpec{"application": {}}, outputs, nil)).To(ConsistOf(`outputs[missing]`))
+	})
+})
@@ -106,4 +106,18 @@ var _ = Describe("Pipeline validation #verifyHostNameNotFilteredForGCL", func()
 		cond := verifyHostNameNotFilteredForGCL(pipelineSpec, outputs, filters)
 		Expect(cond).To(BeEmpty())
 	})
+	It("should not return empty when a mutate filter renames `.hostname` for pipeline with GCL output", func() {
+		renameHost := obs.FilterSpec{
+			Name: "prune",
+			Type: obs.FilterTypeMutate,
+			MutateFilterSpec: []obs.MutateOperation{
+				{Type: obs.MutateOperationRename, Field: ".hostname", Target: ".host.name"},
+			},
+		}
+		filters = map[string]*obs.FilterSpec{renameHost.Name: &renameHost}
+		outputs = map[string]obs.OutputSpec{gclOutput.Name: gclOutput}
+
+		cond := verifyHostNameNotFilteredForGCL(pipelineSpec, outputs, filters)
+		Expect(cond).To(ConsistOf(ContainSubstring("removes the `.hostname` field")))
+	})
 })
@@ -19,6 +19,7 @@ func Validate(context internalcontext.ForwarderContext) {
 			messages = append(messages, fmt.Sprintf("refs not found: %s", strings.Join(refMessages, ",")))
 		}
 		messages = append(messages, verifyHostNameNotFilteredForGCL(pipelineSpec, outputs, filters)...)
+		messages = append(messages, validateFailover(pipelineSpec, outputs)...)
 		if len(messages) > 0 {
 			internalobs.SetCondition(&context.Forwarder.Status.PipelineConditions,
 				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidPipelinePrefix, pipelineSpec.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")))
@@ -44,7 +45,7 @@ func validateRef(pipeline obs.PipelineSpec, inputs map[string]obs.InputSpec, out
 	}
 
 	var outputRefs []string
-	for _, ref := range pipeline.OutputRefs {
+	for _, ref := range internalobs.OutputRefs(pipeline) {
 		if _, found := outputs[ref]; !found {
 			outputRefs = append(outputRefs, ref)
 		}
@@ -65,17 +66,20 @@ func validateRef(pipeline obs.PipelineSpec, inputs map[string]obs.InputSpec, out
 	return results
 }
 
-// verifyHostNameNotFilteredForGCL verifies that within a pipeline featuring a GCL sink and prune filters, the `.hostname` field is exempted from pruning.
+// verifyHostNameNotFilteredForGCL verifies that within a pipeline featuring a GCL sink and prune or mutate filters, the `.hostname` field is exempted from pruning
+// and is not removed by a mutation.
 func verifyHostNameNotFilteredForGCL(pipeline obs.PipelineSpec, outputs map[string]obs.OutputSpec, filters map[string]*obs.FilterSpec) (results []string) {
 	if len(pipeline.FilterRefs) == 0 {
 		return nil
 	}
 
-	for _, out := range pipeline.OutputRefs {
+	for _, out := range internalobs.OutputRefs(pipeline) {
 		if output, exists := outputs[out]; exists && output.Type == obs.OutputTypeGoogleCloudLogging {
 			for _, f := range pipeline.FilterRefs {
 				if filterSpec, ok := filters[f]; ok && prunesHostName(*filterSpec) {
 					results = append(results, fmt.Sprintf("%q prunes the `.hostname` field which is required for output: %q of type %q.", filterSpec.Name, output.Name, output.Type))
+				} else if ok && mutatesHostName(*filterSpec) {
+					results = append(results, fmt.Sprintf("%q removes the `.hostname` field which is required for output: %q of type %q.", filterSpec.Name, output.Name, output.Type))
 				}
 			}
 		}
@@ -118,3 +122,40 @@ func prunesHostName(filter obs.FilterSpec) bool {
 
 	return inListPrunes || notInListPrunes
 }
+
+// mutatesHostName checks if a mutate filter deletes or renames the `.hostname` field
+func mutatesHostName(filter obs.FilterSpec) bool {
+	if filter.Type != obs.FilterTypeMutate {
+		return false
+	}
+	for _, op := range filter.MutateFilterSpec {
+		if op.Field == ".hostname" && (op.Type == obs.MutateOperationDelete || op.Type == obs.MutateOperationRename) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateFailover validates the outputs of a failover group are unique and not also referenced by the pipeline outside
+// of the group
+func validateFailover(pipeline obs.PipelineSpec, outputs map[string]obs.OutputSpec) (results []string) {
+	if pipeline.Failover == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	for _, ref := range pipeline.Failover.OutputRefs {
+		if seen[ref] {
+			results = append(results, fmt.Sprintf("output %q is listed more than once in the failover group", ref))
+		}
+		seen[ref] = true
+		if output, found := outputs[ref]; found && output.Type == obs.OutputTypeLokiStack {
+			results = append(results, fmt.Sprintf("output %q of type %q is not supported in a failover group", ref, output.Type))
+		}
+	}
+	for _, ref := range pipeline.OutputRefs {
+		if seen[ref] {
+			results = append(results, fmt.Sprintf("output %q can not be referenced by both outputRefs and the failover group", ref))
+		}
+	}
+	return results
+}
@@ -11,14 +11,14 @@ import (
 )
 
 var (
-	clfValidators = []func(internalcontext.ForwarderContext){
+	specValidators = []func(internalcontext.ForwarderContext){
 		validateAnnotations,
-		ValidatePermissions,
 		inputs.Validate,
 		outputs.Validate,
 		filters.Validate,
 		pipelines.Validate,
 	}
+	clfValidators = append([]func(internalcontext.ForwarderContext){ValidatePermissions}, specValidators...)
 )
 
 // ValidateClusterLogForwarder validates the forwarder spec that can not be accomplished using api attributes and returns a set of conditions that apply to the spec
@@ -28,6 +28,14 @@ func ValidateClusterLogForwarder(context internalcontext.ForwarderContext) {
 	}
 }
 
+// ValidateClusterLogForwarderSpec validates the inputs, outputs, filters, pipelines and annotations of the forwarder
+// without evaluating the permissions of its serviceAccount
+func ValidateClusterLogForwarderSpec(context internalcontext.ForwarderContext) {
+	for _, validate := range specValidators {
+		validate(context)
+	}
+}
+
 func MustUndeployCollector(conditions []metav1.Condition) bool {
 	for _, condition := range conditions {
 		if condition.Type == obs.ConditionTypeAuthorized && condition.Status == obs.ConditionFalse {
@@ -6,6 +6,7 @@ import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	utilsjson "github.com/openshift/cluster-logging-operator/internal/utils/json"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"regexp"
@@ -52,6 +53,20 @@ func ValidatePermissions(context internalcontext.ForwarderContext) {
 			internalobs.NewCondition(obs.ConditionTypeAuthorized, obs.ConditionFalse, obs.ReasonClusterRoleMissing, err.Error()))
 		return
 	}
+	if hasNamespaceSelector(*clf) {
+		if err = validateReadPermissions(k8sClient, *serviceAccount, "namespaces", "evaluate namespace selectors"); err != nil {
+			internalobs.SetCondition(&clf.Status.Conditions,
+				internalobs.NewCondition(obs.ConditionTypeAuthorized, obs.ConditionFalse, obs.ReasonClusterRoleMissing, err.Error()))
+			return
+		}
+	}
+	if hasEventsInput(*clf) {
+		if err = validateReadPermissions(k8sClient, *serviceAccount, "events", "watch events for events inputs"); err != nil {
+			internalobs.SetCondition(&clf.Status.Conditions,
+				internalobs.NewCondition(obs.ConditionTypeAuthorized, obs.ConditionFalse, obs.ReasonClusterRoleMissing, err.Error()))
+			return
+		}
+	}
 	internalobs.SetCondition(&clf.Status.Conditions,
 		internalobs.NewCondition(obs.ConditionTypeAuthorized, obs.ConditionTrue, obs.ReasonClusterRolesExist,
 			fmt.Sprintf("permitted to collect log types: %v", clfInputs.List())))
@@ -129,7 +144,7 @@ func gatherPipelineInputs(clf obs.ClusterLogForwarder) (sets.String, bool) {
 				// Check if infra namespaces are spec'd
 				if input.Application != nil && len(input.Application.Includes) > 0 {
 					for _, in := range input.Application.Includes {
-						if infraNamespaces.MatchString(in.Namespace) {
+						if infraNamespaces.MatchString(in.Namespace) || utils.MatchesAnyGlob(in.Namespace, clf.Spec.InfrastructureNamespaces...) {
 							inputTypes.Insert(string(obs.InputTypeInfrastructure))
 						}
 					}
@@ -138,6 +153,9 @@ func gatherPipelineInputs(clf obs.ClusterLogForwarder) (sets.String, bool) {
 				inputTypes.Insert(string(obs.InputTypeInfrastructure))
 			case obs.InputTypeAudit:
 				inputTypes.Insert(string(obs.InputTypeAudit))
+			case obs.InputTypeEvents:
+				// events are forwarded as infrastructure logs
+				inputTypes.Insert(string(obs.InputTypeInfrastructure))
 			case obs.InputTypeReceiver:
 				noOfReceivers += 1
 				if input.Receiver.Type == obs.ReceiverTypeSyslog {
@@ -150,6 +168,54 @@ func gatherPipelineInputs(clf obs.ClusterLogForwarder) (sets.String, bool) {
 	return *inputTypes, noOfReceivers > 0
 }
 
+// hasNamespaceSelector returns true if an application input of a pipeline selects namespaces by their labels
+func hasNamespaceSelector(clf obs.ClusterLogForwarder) bool {
+	inputRefs := sets.NewString()
+	for _, pipeline := range clf.Spec.Pipelines {
+		inputRefs.Insert(pipeline.InputRefs...)
+	}
+	for _, input := range clf.Spec.Inputs {
+		if inputRefs.Has(input.Name) && input.Type == obs.InputTypeApplication && input.Application != nil && input.Application.NamespaceSelector != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// hasEventsInput returns true if an events input is referenced by a pipeline
+func hasEventsInput(clf obs.ClusterLogForwarder) bool {
+	inputRefs := sets.NewString()
+	for _, pipeline := range clf.Spec.Pipelines {
+		inputRefs.Insert(pipeline.InputRefs...)
+	}
+	for _, input := range clf.Spec.Inputs {
+		if inputRefs.Has(input.Name) && input.Type == obs.InputTypeEvents {
+			return true
+		}
+	}
+	return false
+}
+
+// validateReadPermissions validates the service account can list and watch a resource in all namespaces. The collector
+// evaluates namespace selectors against the labels of the namespaces it watches and the event watcher watches events
+func validateReadPermissions(k8sClient client.Client, serviceAccount corev1.ServiceAccount, resource, purpose string) error {
+	username := fmt.Sprintf("system:serviceaccount:%s:%s", serviceAccount.Namespace, serviceAccount.Name)
+	var failedVerbs []string
+	for _, verb := range []string{"list", "watch"} {
+		sar := createSubjectAccessReview(username, allNamespaces, verb, resource, "", "")
+		if err := k8sClient.Create(context.TODO(), sar); err != nil {
+			return err
+		}
+		if !sar.Status.Allowed {
+			failedVerbs = append(failedVerbs, verb)
+		}
+	}
+	if len(failedVerbs) > 0 {
+		return errors.NewValidationError("insufficient permissions on service account, not authorized to %q %s to %s", failedVerbs, resource, purpose)
+	}
+	return nil
+}
+
 func createSubjectAccessReview(user, namespace, verb, resource, name, resourceAPIGroup string) *authorizationapi.SubjectAccessReview {
 	sar := &authorizationapi.SubjectAccessReview{
 		Spec: authorizationapi.SubjectAccessReviewSpec{
@@ -320,6 +320,40 @@ var _ = Describe("[internal][validations] validate clusterlogforwarder permissio
 					Entry("with multiple namespaces including an infra namespace", []string{"kube*", "custom-ns"}),
 				)
 
+				It("when including an additional infrastructure namespace", func() {
+					customClf.Spec = obs.ClusterLogForwarderSpec{
+						ServiceAccount: obs.ServiceAccount{
+							Name: clfServiceAccount.Name,
+						},
+						Inputs: []obs.InputSpec{
+							{
+								Name: appWithInfraNSInputName,
+								Type: obs.InputTypeApplication,
+								Application: &obs.Application{
+									Includes: []obs.NamespaceContainerSpec{
+										{Namespace: "platform-auth"},
+									},
+								},
+							},
+						},
+						InfrastructureNamespaces: []string{"platform-*"},
+						Pipelines: []obs.PipelineSpec{
+							{
+								Name: "pipeline1",
+								InputRefs: []string{
+									appWithInfraNSInputName,
+								},
+							},
+						},
+					}
+					ValidatePermissions(internalcontext.ForwarderContext{
+						Client:    k8sAppClient,
+						Reader:    k8sAppClient,
+						Forwarder: &customClf,
+					})
+					Expect(customClf.Status.Conditions).To(HaveCondition(obs.ConditionTypeAuthorized, false, obs.ReasonClusterRoleMissing, ""))
+				})
+
 				It("when including infra namespaces and excluding other namespaces", func() {
 					customClf.Spec = obs.ClusterLogForwarderSpec{
 						ServiceAccount: obs.ServiceAccount{
@@ -438,6 +472,78 @@ var _ = Describe("[internal][validations] validate clusterlogforwarder permissio
 				})
 			})
 		})
+
+		Context("when an application input selects namespaces by labels", func() {
+			BeforeEach(func() {
+				customClf.Spec.Inputs = []obs.InputSpec{
+					{
+						Name: "tenants",
+						Type: obs.InputTypeApplication,
+						Application: &obs.Application{
+							NamespaceSelector: &v1.LabelSelector{
+								MatchLabels: map[string]string{"tenant": "acme"},
+							},
+						},
+					},
+				}
+				customClf.Spec.Pipelines = []obs.PipelineSpec{
+					{
+						Name:      "pipeline1",
+						InputRefs: []string{"tenants"},
+					},
+				}
+			})
+			It("should pass validation when service account can read namespaces", func() {
+				k8sClient = &mockNamespaceSARClient{
+					Client:          fake.NewClientBuilder().WithObjects(clfServiceAccount).Build(),
+					allowNamespaces: true,
+				}
+				expectValidateToSucceed(true, "")
+			})
+			It("should fail validation when service account cannot read namespaces", func() {
+				k8sClient = &mockNamespaceSARClient{
+					Client: fake.NewClientBuilder().WithObjects(clfServiceAccount).Build(),
+				}
+				expectValidateToSucceed(false, "namespace selectors")
+			})
+		})
+
+		Context("when an events input is referenced", func() {
+			BeforeEach(func() {
+				customClf.Spec.Inputs = []obs.InputSpec{
+					{
+						Name:   "cluster-events",
+						Type:   obs.InputTypeEvents,
+						Events: &obs.Events{},
+					},
+				}
+				customClf.Spec.Pipelines = []obs.PipelineSpec{
+					{
+						Name:      "pipeline1",
+						InputRefs: []string{"cluster-events"},
+					},
+				}
+			})
+			It("should pass validation when service account can collect infrastructure logs and watch events", func() {
+				k8sClient = &mockEventsSARClient{
+					Client:      fake.NewClientBuilder().WithObjects(clfServiceAccount).Build(),
+					allowEvents: true,
+				}
+				expectValidateToSucceed(true, "")
+			})
+			It("should fail validation when service account cannot watch events", func() {
+				k8sClient = &mockEventsSARClient{
+					Client: fake.NewClientBuilder().WithObjects(clfServiceAccount).Build(),
+				}
+				expectValidateToSucceed(false, "events inputs")
+			})
+			It("should fail validation when service account cannot collect infrastructure logs", func() {
+				k8sClient = &mockAppSARClient{
+					Client: fake.NewClientBuilder().WithObjects(clfServiceAccount).Build(),
+				}
+				expectValidateToSucceed(false, "infrastructure")
+			})
+		})
 	})
 })
 
@@ -489,3 +595,41 @@ func (c *mockAppSARClient) Create(ctx context.Context, obj client.Object, opts .
 	}
 	return nil
 }
+
+type mockNamespaceSARClient struct {
+	client.Client
+	allowNamespaces bool
+}
+
+func (c *mockNamespaceSARClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	sar, ok := obj.(*authorizationapi.SubjectAccessReview)
+	if !ok {
+		return fmt.Errorf("unexpected object type: %T", obj)
+	}
+	switch sar.Spec.ResourceAttributes.Resource {
+	case "namespaces":
+		sar.Status.Allowed = c.allowNamespaces
+	default:
+		sar.Status.Allowed = sar.Spec.ResourceAttributes.Name == string(obs.InputTypeApplication)
+	}
+	return nil
+}
+
+type mockEventsSARClient struct {
+	client.Client
+	allowEvents bool
+}
+
+func (c *mockEventsSARClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	sar, ok := obj.(*authorizationapi.SubjectAccessReview)
+	if !ok {
+		return fmt.Errorf("unexpected object type: %T", obj)
+	}
+	switch sar.Spec.ResourceAttributes.Resource {
+	case "events":
+		sar.Status.Allowed = c.allowEvents
+	default:
+		sar.Status.Allowed = sar.Spec.ResourceAttributes.Name == string(obs.InputTypeInfrastructure)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/enrich"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	validations "github.com/openshift/cluster-logging-operator/internal/validations/observability"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/set"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	loggerName = "webhook.observability"
+
+	// standInValue is the value of the keys of secrets and configmaps that do not exist when a forwarder is admitted
+	standInValue = "stand-in"
+)
+
+// +kubebuilder:webhook:path=/validate-observability-openshift-io-v1-clusterlogforwarder,mutating=false,failurePolicy=fail,sideEffects=None,groups=observability.openshift.io,resources=clusterlogforwarders,verbs=create;update,versions=v1,name=vclusterlogforwarder.observability.openshift.io,admissionReviewVersions=v1
+
+// ClusterLogForwarderValidator rejects forwarders with inputs, outputs, filters, pipelines or annotations that fail the
+// validations evaluated by the controller.  The permissions of the serviceAccount and the contents of secrets and
+// configmaps that do not exist at admission are only validated by the controller, which reports them in the status
+type ClusterLogForwarderValidator struct {
+	// Client retrieves the secrets and configmaps referenced by a forwarder
+	Client client.Client
+}
+
+var _ admission.CustomValidator = &ClusterLogForwarderValidator{}
+
+// SetupWithManager registers the validating webhook with the manager
+func (v *ClusterLogForwarderValidator) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&obs.ClusterLogForwarder{}).
+		WithValidator(v).
+		Complete()
+}
+
+func (v *ClusterLogForwarderValidator) ValidateCreate(ctx context.Context, obj kruntime.Object) (admission.Warnings, error) {
+	return v.validate(ctx, obj)
+}
+
+func (v *ClusterLogForwarderValidator) ValidateUpdate(ctx context.Context, _, newObj kruntime.Object) (admission.Warnings, error) {
+	return v.validate(ctx, newObj)
+}
+
+func (v *ClusterLogForwarderValidator) ValidateDelete(_ context.Context, _ kruntime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *ClusterLogForwarderValidator) validate(_ context.Context, obj kruntime.Object) (admission.Warnings, error) {
+	forwarder, ok := obj.(*obs.ClusterLogForwarder)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterLogForwarder but got %T", obj)
+	}
+	if forwarder.Spec.ManagementState == obs.ManagementStateUnmanaged {
+		return nil, nil
+	}
+	log.WithName(loggerName).V(3).Info("validate", "namespace", forwarder.Namespace, "name", forwarder.Name)
+
+	reconciler := observability.ClusterLogForwarderReconciler{
+		ForwarderContext: internalcontext.ForwarderContext{
+			Client:    v.Client,
+			Forwarder: forwarder.DeepCopy(),
+		},
+	}
+	reconciler.Forwarder.Status = obs.ClusterLogForwarderStatus{}
+	if err := reconciler.Initialize(); err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	warnings := standInMissingReferences(reconciler.ForwarderContext)
+	warnings = append(warnings, deprecationWarnings(*forwarder)...)
+
+	validations.ValidateClusterLogForwarderSpec(reconciler.ForwarderContext)
+	if errs := failures(reconciler.Forwarder.Status); len(errs) > 0 {
+		return warnings, apierrors.NewInvalid(obs.GroupVersion.WithKind("ClusterLogForwarder").GroupKind(), forwarder.Name, errs)
+	}
+	return warnings, nil
+}
+
+// standInMissingReferences adds a stand-in for each referenced secret and configmap that does not exist so a
+// forwarder may be admitted before the objects it references.  The missing objects are returned as warnings.  The
+// stand-ins satisfy the validations of the values they hold (e.g. a redact salt, a role ARN or a lookup table), which
+// are evaluated against the actual objects when the forwarder is reconciled
+func standInMissingReferences(context internalcontext.ForwarderContext) (warnings admission.Warnings) {
+	var refs []*obs.ValueReference
+	for _, o := range context.Forwarder.Spec.Outputs {
+		refs = append(refs, internalobs.SecretReferencesAsValueReferences(o)...)
+		if o.TLS != nil {
+			refs = append(refs, internalobs.ValueReferences(o.TLS.TLSSpec)...)
+		}
+	}
+	for _, i := range context.Forwarder.Spec.Inputs {
+		if i.Receiver != nil && i.Receiver.TLS != nil {
+			refs = append(refs, internalobs.ValueReferences(*internalobs.ReceiverTLS(*i.Receiver))...)
+		}
+	}
+	for _, ref := range internalobs.Filters(context.Forwarder.Spec.Filters).SecretReferences() {
+		refs = append(refs, &obs.ValueReference{Key: ref.Key, SecretName: ref.SecretName})
+	}
+	for _, ref := range internalobs.Filters(context.Forwarder.Spec.Filters).ConfigMapReferences() {
+		refs = append(refs, &obs.ValueReference{Key: ref.Key, ConfigMapName: ref.ConfigMapName})
+	}
+	namespace := context.Forwarder.Namespace
+	secrets := set.New[string]()
+	configMaps := set.New[string]()
+	for _, ref := range refs {
+		switch {
+		case ref.SecretName != "":
+			if _, found := context.Secrets[ref.SecretName]; !found {
+				warnings = append(warnings, fmt.Sprintf("secret %q does not exist", ref.SecretName))
+				context.Secrets[ref.SecretName] = runtime.NewSecret(namespace, ref.SecretName, map[string][]byte{})
+				secrets.Insert(ref.SecretName)
+			}
+			if secrets.Has(ref.SecretName) {
+				context.Secrets[ref.SecretName].Data[ref.Key] = []byte(standInValue)
+			}
+		case ref.ConfigMapName != "":
+			if _, found := context.ConfigMaps[ref.ConfigMapName]; !found {
+				warnings = append(warnings, fmt.Sprintf("configmap %q does not exist", ref.ConfigMapName))
+				context.ConfigMaps[ref.ConfigMapName] = runtime.NewConfigMap(namespace, ref.ConfigMapName, map[string]string{})
+				configMaps.Insert(ref.ConfigMapName)
+			}
+			if configMaps.Has(ref.ConfigMapName) {
+				context.ConfigMaps[ref.ConfigMapName].Data[ref.Key] = standInValue
+			}
+		}
+	}
+	// Lookup tables are validated so they are stood in with empty tables of the expected shape
+	for _, f := range context.Forwarder.Spec.Filters {
+		if f.Type == obs.FilterTypeEnrich && f.EnrichFilterSpec != nil && configMaps.Has(f.EnrichFilterSpec.Table.ConfigMapName) {
+			context.ConfigMaps[f.EnrichFilterSpec.Table.ConfigMapName].Data[f.EnrichFilterSpec.Table.Key] = enrich.StandInTable(*f.EnrichFilterSpec)
+		}
+	}
+	return warnings
+}
+
+// deprecationWarnings warns about the use of deprecated or tech-preview features
+func deprecationWarnings(forwarder obs.ClusterLogForwarder) (warnings admission.Warnings) {
+	if _, found := forwarder.Annotations[constants.AnnotationEnableCollectorAsDeployment]; found {
+		warnings = append(warnings, fmt.Sprintf("annotation %q is dev-preview", constants.AnnotationEnableCollectorAsDeployment))
+	}
+	for _, i := range forwarder.Spec.Inputs {
+		if i.Receiver != nil && i.Receiver.Type == obs.ReceiverTypeOTLP {
+			warnings = append(warnings, fmt.Sprintf("input %q of receiver type %q is tech-preview", i.Name, i.Receiver.Type))
+		}
+	}
+	for _, o := range forwarder.Spec.Outputs {
+		if o.Type == obs.OutputTypeOTLP {
+			warnings = append(warnings, fmt.Sprintf("output %q of type %q is tech-preview", o.Name, o.Type))
+		}
+	}
+	return warnings
+}
+
+// failures converts the failed validation conditions of a forwarder to field errors
+func failures(status obs.ClusterLogForwarderStatus) (errs field.ErrorList) {
+	spec := field.NewPath("spec")
+	for _, c := range status.Conditions {
+		if c.Type == obs.ConditionTypeLogLevel && c.Status == obs.ConditionFalse {
+			errs = append(errs, field.Invalid(field.NewPath("metadata", "annotations").Key(constants.AnnotationVectorLogLevel), "", c.Message))
+		}
+	}
+	errs = append(errs, conditionErrors(spec.Child("inputs"), obs.ConditionTypeValidInputPrefix, status.InputConditions)...)
+	errs = append(errs, conditionErrors(spec.Child("outputs"), obs.ConditionTypeValidOutputPrefix, status.OutputConditions)...)
+	errs = append(errs, conditionErrors(spec.Child("filters"), obs.ConditionTypeValidFilterPrefix, status.FilterConditions)...)
+	errs = append(errs, conditionErrors(spec.Child("pipelines"), obs.ConditionTypeValidPipelinePrefix, status.PipelineConditions)...)
+	return errs
+}
+
+func conditionErrors(path *field.Path, prefix string, conditions []metav1.Condition) (errs field.ErrorList) {
+	for _, c := range conditions {
+		if c.Status == obs.ConditionFalse && strings.HasPrefix(c.Type, prefix+"-") {
+			name := strings.TrimPrefix(c.Type, prefix+"-")
+			errs = append(errs, field.Invalid(path.Key(name), name, c.Message))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,142 @@
+package observability_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	"github.com/openshift/cluster-logging-operator/internal/webhook/observability"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ClusterLogForwarderValidator", func() {
+
+	const namespace = "mynamespace"
+
+	var (
+		validator *observability.ClusterLogForwarderValidator
+		forwarder *obs.ClusterLogForwarder
+	)
+
+	BeforeEach(func() {
+		Expect(obs.AddToScheme(scheme.Scheme)).To(Succeed())
+		validator = &observability.ClusterLogForwarderValidator{
+			Client: fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(runtime.NewSecret(namespace, "es-auth", map[string][]byte{
+					"username": []byte("user"),
+					"password": []byte("pass"),
+				})).
+				Build(),
+		}
+		forwarder = obsruntime.NewClusterLogForwarder(namespace, "myforwarder", runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+			clf.Spec.ServiceAccount.Name = "logcollector"
+			clf.Spec.Outputs = []obs.OutputSpec{
+				{
+					Name: "es",
+					Type: obs.OutputTypeElasticsearch,
+					Elasticsearch: &obs.Elasticsearch{
+						URLSpec: obs.URLSpec{URL: "https://es.svc:9200"},
+						Index:   "{.log_type||\"none\"}",
+						Authentication: &obs.HTTPAuthentication{
+							Username: &obs.SecretReference{Key: "username", SecretName: "es-auth"},
+							Password: &obs.SecretReference{Key: "password", SecretName: "es-auth"},
+						},
+					},
+				},
+			}
+			clf.Spec.Pipelines = []obs.PipelineSpec{
+				{
+					Name:       "mypipeline",
+					InputRefs:  []string{string(obs.InputTypeApplication)},
+					OutputRefs: []string{"es"},
+				},
+			}
+		})
+	})
+
+	It("should admit a valid forwarder", func() {
+		warnings, err := validator.ValidateCreate(context.TODO(), forwarder)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("should reject a forwarder with invalid pipelines", func() {
+		forwarder.Spec.Pipelines[0].OutputRefs = []string{"missing"}
+		_, err := validator.ValidateUpdate(context.TODO(), forwarder, forwarder)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue(), "exp an invalid error: %v", err)
+		Expect(err.Error()).To(ContainSubstring(`spec.pipelines[mypipeline]`))
+	})
+
+	It("should reject a forwarder with an invalid log level", func() {
+		forwarder.Annotations = map[string]string{"observability.openshift.io/log-level": "verbose"}
+		_, err := validator.ValidateCreate(context.TODO(), forwarder)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue(), "exp an invalid error: %v", err)
+		Expect(err.Error()).To(ContainSubstring(`metadata.annotations[observability.openshift.io/log-level]`))
+	})
+
+	It("should admit a forwarder that references secrets which do not exist yet with a warning", func() {
+		forwarder.Spec.Outputs[0].Elasticsearch.Authentication.Username.SecretName = "other-auth"
+		warnings, err := validator.ValidateCreate(context.TODO(), forwarder)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(ConsistOf(`secret "other-auth" does not exist`))
+	})
+
+	It("should admit a forwarder with lookup tables which do not exist yet with a warning", func() {
+		forwarder.Spec.Filters = []obs.FilterSpec{
+			{
+				Name: "teams",
+				Type: obs.FilterTypeEnrich,
+				EnrichFilterSpec: &obs.EnrichFilterSpec{
+					Table:     obs.ConfigMapReference{Key: "teams.json", ConfigMapName: "teams"},
+					Format:    obs.EnrichTableFormatJSON,
+					Key:       `{.kubernetes.namespace_name||"none"}`,
+					KeyColumn: "namespace",
+					Columns:   []string{"team"},
+				},
+			},
+		}
+		forwarder.Spec.Pipelines[0].FilterRefs = []string{"teams"}
+		warnings, err := validator.ValidateCreate(context.TODO(), forwarder)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(ConsistOf(`configmap "teams" does not exist`))
+	})
+
+	It("should reject a forwarder that references missing keys of existing secrets", func() {
+		forwarder.Spec.Outputs[0].Elasticsearch.Authentication.Username.Key = "missing"
+		_, err := validator.ValidateCreate(context.TODO(), forwarder)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue(), "exp an invalid error: %v", err)
+		Expect(err.Error()).To(ContainSubstring(`spec.outputs[es]`))
+	})
+
+	It("should warn about tech-preview features", func() {
+		forwarder.Annotations = map[string]string{"observability.openshift.io/tech-preview-otlp-output": "enabled"}
+		forwarder.Spec.Outputs = append(forwarder.Spec.Outputs, obs.OutputSpec{
+			Name: "otlp",
+			Type: obs.OutputTypeOTLP,
+			OTLP: &obs.OTLP{URL: "https://otlp.svc:4318/v1/logs"},
+		})
+		forwarder.Spec.Pipelines[0].OutputRefs = append(forwarder.Spec.Pipelines[0].OutputRefs, "otlp")
+		warnings, err := validator.ValidateCreate(context.TODO(), forwarder)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(ConsistOf(`output "otlp" of type "otlp" is tech-preview`))
+	})
+
+	It("should not validate unmanaged forwarders", func() {
+		forwarder.Spec.ManagementState = obs.ManagementStateUnmanaged
+		forwarder.Spec.Pipelines[0].OutputRefs = []string{"missing"}
+		_, err := validator.ValidateCreate(context.TODO(), forwarder)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject objects that are not forwarders", func() {
+		_, err := validator.ValidateCreate(context.TODO(), &corev1.Secret{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -380,9 +380,10 @@ func (f *CollectorFunctionalFramework) deploySecrets() error {
 }
 
 func (f *CollectorFunctionalFramework) mapOutputSecrets() map[string]*corev1.Secret {
-	// Gather output secrets
+	// Gather output and filter secrets
 	outputs := internalobs.Outputs(f.Forwarder.Spec.Outputs)
 	names := set.New(outputs.SecretNames()...)
+	names.Insert(internalobs.Filters(f.Forwarder.Spec.Filters).SecretNames()...)
 	frameworkSecrets := f.mapFrameworkSecrets()
 
 	outputSecretMap := map[string]*corev1.Secret{}
@@ -432,6 +433,10 @@ func (f *CollectorFunctionalFramework) addOutputContainers(b *runtime.PodBuilder
 			if err := f.AddCloudWatchOutput(b, output); err != nil {
 				return err
 			}
+		case obs.OutputTypeS3:
+			if err := f.AddS3Output(b, output); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -0,0 +1,65 @@
+package functional
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	MinIOImage = "quay.io/minio/minio:RELEASE.2024-05-10T01-41-37Z"
+	MinIOPort  = 9000
+	S3Bucket   = "logs"
+	S3Secret   = "s3-secret"
+)
+
+// minioStartupScript starts the MinIO server and creates the bucket where the collector archives logs
+var minioStartupScript = fmt.Sprintf(`
+minio server /data --address :%d &
+until mc alias set local http://localhost:%d "$MINIO_ROOT_USER" "$MINIO_ROOT_PASSWORD"; do sleep 1; done
+mc mb --ignore-existing local/%s
+wait
+`, MinIOPort, MinIOPort, S3Bucket)
+
+// AddS3Output adds a MinIO server as a local stand-in for Amazon S3
+func (f *CollectorFunctionalFramework) AddS3Output(b *runtime.PodBuilder, output obs.OutputSpec) error {
+	log.V(2).Info("Adding MinIO container", "name", output.Name, "image", MinIOImage)
+	b.AddContainer(string(obs.OutputTypeS3), MinIOImage).
+		AddEnvVar("MINIO_ROOT_USER", AwsAccessKeyID).
+		AddEnvVar("MINIO_ROOT_PASSWORD", AwsSecretAccessKey).
+		AddEnvVar("HOME", "/tmp").
+		AddContainerPort("minio", MinIOPort).
+		AddVolumeMount("minio-data", "/data", "", false).
+		WithCmd([]string{"/bin/sh", "-c", minioStartupScript}).
+		End().
+		AddEmptyDirVolume("minio-data")
+	return nil
+}
+
+// ReadLogsFromS3 returns the records of all the objects stored in the bucket under the given key prefix
+func (f *CollectorFunctionalFramework) ReadLogsFromS3(keyPrefix string) (results []string, err error) {
+	var output string
+	cmd := fmt.Sprintf(`mc find local/%s/%s --exec "mc cat {}"`, S3Bucket, keyPrefix)
+	err = wait.PollUntilContextTimeout(context.TODO(), defaultRetryInterval, f.GetMaxReadDuration(), true, func(cxt context.Context) (done bool, err error) {
+		output, err = f.RunCommand(string(obs.OutputTypeS3), "/bin/sh", "-c", cmd)
+		if output == "" || err != nil {
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if line != "" {
+			results = append(results, line)
+		}
+	}
+	return results, nil
+}
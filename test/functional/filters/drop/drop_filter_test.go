@@ -6,6 +6,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/test/framework/functional"
 	"github.com/openshift/cluster-logging-operator/test/helpers/types"
 	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"time"
 
 	. "github.com/onsi/ginkgo"
@@ -120,6 +121,76 @@ var _ = Describe("[Functional][Filters][Drop] Drop filter", func() {
 			}
 		})
 
+		It("should drop logs with a level below warn", func() {
+			f = functional.NewCollectorFunctionalFramework()
+
+			testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+				FromInput(obs.InputTypeApplication).
+				WithFilter(dropFilterName, func(spec *obs.FilterSpec) {
+					spec.Type = obs.FilterTypeDrop
+					spec.DropTestsSpec = []obs.DropTest{
+						{
+							DropConditions: []obs.DropCondition{
+								{
+									Field:      ".level",
+									LevelBelow: "warn",
+								},
+							},
+						},
+					}
+				}).
+				ToElasticSearchOutput()
+
+			Expect(f.Deploy()).To(BeNil())
+			for _, level := range []string{"debug", "info", "warn", "error"} {
+				msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), fmt.Sprintf("level=%s message", level))
+				Expect(f.WriteMessagesToApplicationLog(msg, 1)).To(BeNil())
+			}
+
+			logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeElasticsearch))
+			Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeElasticsearch, err)
+			Expect(logs).To(HaveLen(2), "Exp. only the warn and error logs to be forwarded to %s", obs.OutputTypeElasticsearch)
+			for _, msg := range logs {
+				Expect(msg.Level).To(BeElementOf("warn", "error"))
+			}
+		})
+
+		It("should drop logs that have `.responseStatus.code` greater than a decimal number", func() {
+			f = functional.NewCollectorFunctionalFramework()
+
+			testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+				FromInput(obs.InputTypeAudit).
+				WithFilter(dropFilterName, func(spec *obs.FilterSpec) {
+					spec.Type = obs.FilterTypeDrop
+					spec.DropTestsSpec = []obs.DropTest{
+						{
+							DropConditions: []obs.DropCondition{
+								{
+									Field:       ".responseStatus.code",
+									GreaterThan: utils.GetPtr(resource.MustParse("403.5")),
+								},
+							},
+						},
+					}
+				}).
+				ToElasticSearchOutput()
+
+			Expect(f.Deploy()).To(BeNil())
+
+			Expect(f.WriteMessagesToOpenshiftAuditLog(makeLog(403), 10)).To(BeNil())
+			Expect(f.WriteMessagesToOpenshiftAuditLog(makeLog(404), 10)).To(BeNil())
+
+			logs, err := f.ReadAuditLogsFrom(string(obs.OutputTypeElasticsearch))
+			Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeElasticsearch, err)
+			Expect(logs).To(HaveLen(10))
+			var auditLogs []types.OpenshiftAuditLog
+			err = types.StrictlyParseLogs(utils.ToJsonLogs(logs), &auditLogs)
+			Expect(err).To(BeNil(), "Expected no errors parsing the logs: %v", logs)
+			for _, auditLog := range auditLogs {
+				Expect(auditLog.ResponseStatus.Code).To(Equal(403))
+			}
+		})
+
 	})
 
 })
@@ -1,8 +1,11 @@
 package multilineexception
 
 import (
+	"encoding/json"
+	"fmt"
 	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -129,4 +132,48 @@ created by main.main
 		}),
 	)
 
+	It("should reassemble the lines of custom rules keeping the fields of the first line", func() {
+		sqlDump := `SELECT name, value
+  FROM settings
+  WHERE id = 1`
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithFilter("sql", func(spec *obs.FilterSpec) {
+				spec.Type = obs.FilterTypeDetectMultiline
+				spec.DetectMultilineExceptionSpec = &obs.DetectMultilineExceptionFilterSpec{
+					Rules: []obs.MultilineRule{
+						{
+							StartPattern:        `^SELECT\b`,
+							ContinuationPattern: `^\s+`,
+						},
+					},
+				}
+			}).
+			ToHttpOutput()
+		Expect(framework.Deploy()).To(BeNil())
+
+		start, _ := time.Parse(time.RFC3339Nano, timestamp)
+		buffer := []string{}
+		for i, line := range strings.Split(sqlDump, "\n") {
+			lineTime := start.Add(time.Duration(i) * time.Second).Format(time.RFC3339Nano)
+			buffer = append(buffer, functional.NewCRIOLogMessage(lineTime, line, false))
+		}
+		Expect(framework.WriteMessagesToNamespace(strings.Join(buffer, "\n"), framework.Pod.Namespace, 1)).To(Succeed())
+
+		raw, err := framework.ReadRawApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(raw).To(HaveLen(1), "Expected the lines to be reassembled into one record")
+
+		record := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(raw[0]), &record)).To(Succeed())
+		Expect(record).ToNot(HaveKey("@timestamp_end"))
+		Expect(record).ToNot(HaveKey("timestamp_end"))
+
+		logs, err := types.ParseLogs(utils.ToJsonLogs(raw))
+		Expect(err).To(BeNil(), "Expected no errors parsing the logs: %s", raw)
+		Expect(logs[0].Message).To(Equal(sqlDump))
+		Expect(logs[0].Timestamp.Equal(start)).To(BeTrue(), fmt.Sprintf("Expected the timestamp of the first line, got %v", logs[0].Timestamp))
+		Expect(logs[0].Kubernetes.PodName).To(Equal(framework.Pod.Name))
+	})
+
 })
@@ -0,0 +1,56 @@
+package mutate
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Filters][Mutate] Mutate filter", func() {
+	const (
+		mutateFilterName = "my-mutate"
+	)
+
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	BeforeEach(func() {
+		f = functional.NewCollectorFunctionalFramework()
+	})
+
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	It("should set a field to a value templated from the labels of the pod", func() {
+		f.Labels["app"] = "my-app"
+		testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithFilter(mutateFilterName, func(spec *obs.FilterSpec) {
+				spec.Type = obs.FilterTypeMutate
+				spec.MutateFilterSpec = []obs.MutateOperation{
+					{Type: obs.MutateOperationSet, Field: ".service_name", Value: `svc-{.kubernetes.labels.app||"none"}`},
+					{Type: obs.MutateOperationSet, Field: ".team_name", Value: `team-{.kubernetes.labels.team||"none"}`},
+				}
+			}).
+			ToElasticSearchOutput()
+
+		Expect(f.Deploy()).To(BeNil())
+		msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "my message")
+		Expect(f.WriteMessagesToApplicationLog(msg, 1)).To(Succeed())
+
+		raw, err := f.ReadRawApplicationLogsFrom(string(obs.OutputTypeElasticsearch))
+		Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeElasticsearch, err)
+		Expect(raw).To(HaveLen(1))
+		record := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(raw[0]), &record)).To(Succeed())
+		Expect(record).To(HaveKeyWithValue("service_name", "svc-my-app"))
+		Expect(record).To(HaveKeyWithValue("team_name", "team-none"))
+	})
+})
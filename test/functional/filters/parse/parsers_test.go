@@ -0,0 +1,93 @@
+package parse
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[functional][filters][parse] Configurable parsers", func() {
+	const (
+		timestamp = "2020-11-04T18:13:59.061892+00:00"
+	)
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	DescribeTable("should parse the message into the target field", func(parseSpec obs.ParseFilterSpec, message string, expected map[string]interface{}) {
+		framework = functional.NewCollectorFunctionalFramework()
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithParseFilter(parseSpec).
+			ToHttpOutput()
+		ExpectOK(framework.Deploy())
+
+		applicationLogLine := fmt.Sprintf("%s stdout F %s", timestamp, message)
+		Expect(framework.WriteMessagesToApplicationLog(applicationLogLine, 1)).To(Succeed())
+
+		logs, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(logs).To(HaveLen(1), "Expected to receive the log message")
+		Expect(logs[0].Structured).To(Equal(expected))
+		if parseSpec.KeepOriginal {
+			Expect(logs[0].Message).To(Equal(message))
+		} else {
+			Expect(logs[0].Message).To(BeEmpty())
+		}
+	},
+		Entry("with the logfmt parser",
+			obs.ParseFilterSpec{Type: obs.ParserTypeLogfmt},
+			`level=info msg="hello world"`,
+			map[string]interface{}{"level": "info", "msg": "hello world"},
+		),
+		Entry("with the keyValue parser and custom delimiters",
+			obs.ParseFilterSpec{Type: obs.ParserTypeKeyValue, KeyValue: &obs.KeyValueParserSpec{KeyValueDelimiter: ":", FieldDelimiter: ","}},
+			`level:info,user:jdoe`,
+			map[string]interface{}{"level": "info", "user": "jdoe"},
+		),
+		Entry("with the regex parser and keeping the original message",
+			obs.ParseFilterSpec{Type: obs.ParserTypeRegex, KeepOriginal: true, Regex: &obs.RegexParserSpec{Pattern: `^(?P<level>\w+): (?P<msg>.*)$`}},
+			`WARN: disk is almost full`,
+			map[string]interface{}{"level": "WARN", "msg": "disk is almost full"},
+		),
+		Entry("with the csv parser",
+			obs.ParseFilterSpec{Type: obs.ParserTypeCSV, CSV: &obs.CSVParserSpec{Headers: []string{"user", "action", "status"}}},
+			`jdoe,login,success`,
+			map[string]interface{}{"user": "jdoe", "action": "login", "status": "success"},
+		),
+		Entry("with the grok parser",
+			obs.ParseFilterSpec{Type: obs.ParserTypeGrok, Grok: &obs.GrokParserSpec{Pattern: `%{LOGLEVEL:level} %{GREEDYDATA:msg}`}},
+			`ERROR connection refused`,
+			map[string]interface{}{"level": "ERROR", "msg": "connection refused"},
+		),
+	)
+
+	It("should not modify the record when the message can not be parsed", func() {
+		framework = functional.NewCollectorFunctionalFramework()
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithParseFilter(obs.ParseFilterSpec{Type: obs.ParserTypeRegex, Regex: &obs.RegexParserSpec{Pattern: `^(?P<level>\d+)$`}}).
+			ToHttpOutput()
+		ExpectOK(framework.Deploy())
+
+		message := "not a number"
+		applicationLogLine := fmt.Sprintf("%s stdout F %s", timestamp, message)
+		Expect(framework.WriteMessagesToApplicationLog(applicationLogLine, 1)).To(Succeed())
+
+		logs, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(logs).To(HaveLen(1), "Expected to receive the log message")
+		Expect(logs[0].Structured).To(BeNil())
+		Expect(logs[0].Message).To(Equal(message))
+	})
+})
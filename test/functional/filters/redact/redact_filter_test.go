@@ -0,0 +1,112 @@
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[functional][filters][redact] Redact filter", func() {
+	const (
+		timestamp = "2020-11-04T18:13:59.061892+00:00"
+	)
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	writeAndRead := func(message string) string {
+		applicationLogLine := fmt.Sprintf("%s stdout F %s", timestamp, message)
+		Expect(framework.WriteMessagesToApplicationLog(applicationLogLine, 1)).To(Succeed())
+
+		logs, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(logs).To(HaveLen(1), "Expected to receive the log message")
+		return logs[0].Message
+	}
+
+	DescribeTable("should redact the message", func(redactSpec obs.RedactFilterSpec, message, expected string) {
+		framework = functional.NewCollectorFunctionalFramework()
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithRedactFilter(redactSpec).
+			ToHttpOutput()
+		ExpectOK(framework.Deploy())
+
+		Expect(writeAndRead(message)).To(Equal(expected))
+	},
+		Entry("by masking email addresses",
+			obs.RedactFilterSpec{Detectors: []obs.RedactDetector{obs.RedactDetectorEmail}},
+			"login by jdoe@example.com",
+			"login by ****************",
+		),
+		Entry("by replacing only credit card numbers that pass the Luhn checksum",
+			obs.RedactFilterSpec{
+				Detectors:   []obs.RedactDetector{obs.RedactDetectorCreditCard},
+				Replacement: &obs.RedactReplacement{Type: obs.RedactReplacementText, Text: "[CARD]"},
+			},
+			"paid with 4111 1111 1111 1111 order 1234567890123",
+			"paid with [CARD] order 1234567890123",
+		),
+		Entry("by replacing a known-valid PAN and keeping a known-invalid PAN",
+			obs.RedactFilterSpec{
+				Detectors:   []obs.RedactDetector{obs.RedactDetectorCreditCard},
+				Replacement: &obs.RedactReplacement{Type: obs.RedactReplacementText, Text: "[CARD]"},
+			},
+			"paid with 4111111111111111 refunded to 4111111111111112 and 5500-0000-0000-0004",
+			"paid with [CARD] refunded to 4111111111111112 and [CARD]",
+		),
+		Entry("by replacing with text that contains quotes and braces",
+			obs.RedactFilterSpec{
+				Detectors:   []obs.RedactDetector{obs.RedactDetectorEmail},
+				Replacement: &obs.RedactReplacement{Type: obs.RedactReplacementText, Text: `"{email}"`},
+			},
+			"login by jdoe@example.com",
+			`login by "{email}"`,
+		),
+		Entry("by replacing bearer tokens and custom patterns",
+			obs.RedactFilterSpec{
+				Detectors:   []obs.RedactDetector{obs.RedactDetectorBearerToken},
+				Patterns:    []string{`ssn=\d{3}-\d{2}-\d{4}`},
+				Replacement: &obs.RedactReplacement{Type: obs.RedactReplacementText, Text: "[REDACTED]"},
+			},
+			"Authorization: Bearer abc.def-123 ssn=123-45-6789",
+			"Authorization: [REDACTED] [REDACTED]",
+		),
+	)
+
+	It("should hash the values with the salt from a secret", func() {
+		const (
+			salt  = "pepper"
+			email = "jdoe@example.com"
+		)
+		framework = functional.NewCollectorFunctionalFramework()
+		framework.AddSecret(runtime.NewSecret("", "redact-salt", map[string][]byte{"salt": []byte(salt)}))
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithRedactFilter(obs.RedactFilterSpec{
+				Detectors: []obs.RedactDetector{obs.RedactDetectorEmail},
+				Replacement: &obs.RedactReplacement{
+					Type: obs.RedactReplacementHash,
+					Salt: &obs.SecretReference{Key: "salt", SecretName: "redact-salt"},
+				},
+			}).
+			ToHttpOutput()
+		ExpectOK(framework.Deploy())
+
+		hash := sha256.Sum256([]byte(salt + email))
+		Expect(writeAndRead("login by " + email)).To(Equal("login by " + hex.EncodeToString(hash[:])))
+	})
+})
@@ -0,0 +1,43 @@
+package throttle
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/test/client"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestThrottleFilter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[functional][filters][throttle]")
+}
+
+var (
+	// Use a single test client and pod to launch all the vector commands
+	c   *client.Test
+	pod *corev1.Pod
+)
+
+var _ = BeforeSuite(func() {
+	c = client.NewTest()
+	name := "throttle-test"
+	image := utils.GetComponentImage(constants.VectorName)
+	pod = runtime.NewPodBuilder(runtime.NewPod(c.NS.Name, name)).
+		AddContainer(name, image).
+		AddEnvVar("VECTOR_LOG", "warn").
+		WithCmd([]string{"sleep", "1h"}).End().Pod
+	Expect(c.Create(pod)).To(Succeed())
+	Expect(c.WaitFor(pod, client.PodRunning)).To(Succeed())
+})
+
+var _ = AfterSuite(func() {
+	if c != nil {
+		c.Close()
+	}
+})
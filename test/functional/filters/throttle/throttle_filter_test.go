@@ -0,0 +1,109 @@
+package throttle
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/throttle"
+	"github.com/openshift/cluster-logging-operator/test"
+	"github.com/openshift/cluster-logging-operator/test/helpers/cmd"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime"
+)
+
+type record struct {
+	Message    string `json:"message"`
+	Throttled  bool   `json:"throttled,omitempty"`
+	Kubernetes struct {
+		Labels map[string]string `json:"labels"`
+	} `json:"kubernetes"`
+}
+
+// throttled sends the records through a throttle transform of the spec and returns the records it forwards
+func throttled(spec *obs.ThrottleFilterSpec, records []record) (out []record) {
+	conf, err := framework.MakeGenerator().GenerateConf(throttle.New("throttle", spec, "in"))
+	Expect(err).To(BeNil())
+	conf = fmt.Sprintf(`
+# Vector config for tests that read from stdin and print throttled events to stdout
+[sources.in]
+type = "stdin"
+decoding.codec = "json"
+
+%s
+
+[sinks.console]
+type = "console"
+inputs = ["throttle"]
+encoding.codec = "json"
+`, conf)
+	Expect(cmd.PodWrite(pod, "", "/tmp/vector.toml", []byte(conf))).To(Succeed())
+
+	in := &bytes.Buffer{}
+	for _, r := range records {
+		Expect(json.NewEncoder(in).Encode(r)).To(Succeed())
+	}
+	vector := testruntime.Exec(pod, "vector", "-c", "/tmp/vector.toml")
+	vector.Stdin = in
+	vector.Stderr = test.Writer()
+	stdout, err := vector.Output()
+	Expect(err).To(BeNil())
+	for _, line := range strings.Split(strings.TrimSpace(string(stdout)), "\n") {
+		r := record{}
+		Expect(json.Unmarshal([]byte(line), &r)).To(Succeed(), "line: %q", line)
+		out = append(out, r)
+	}
+	return out
+}
+
+// tenantRecords returns records for the tenants labeled on their pods
+func tenantRecords(count int, tenants ...string) (records []record) {
+	for _, tenant := range tenants {
+		for i := 0; i < count; i++ {
+			r := record{Message: fmt.Sprintf("%s-%d", tenant, i)}
+			r.Kubernetes.Labels = map[string]string{"tenant": tenant}
+			records = append(records, r)
+		}
+	}
+	return records
+}
+
+func countByTenant(records []record, throttled bool) map[string]int {
+	counts := map[string]int{}
+	for _, r := range records {
+		if r.Throttled == throttled {
+			counts[r.Kubernetes.Labels["tenant"]]++
+		}
+	}
+	return counts
+}
+
+var _ = Describe("[Functional][Filters][Throttle] Throttle filter", func() {
+
+	It("should drop the records exceeding the threshold of each label value separately", func() {
+		spec := &obs.ThrottleFilterSpec{
+			Key:           `{.kubernetes.labels.tenant||"none"}`,
+			Threshold:     3,
+			WindowSeconds: 60,
+			Action:        obs.ThrottleActionDrop,
+		}
+		out := throttled(spec, tenantRecords(5, "red", "blue"))
+		Expect(countByTenant(out, false)).To(Equal(map[string]int{"red": 3, "blue": 3}))
+	})
+
+	It("should tag the records exceeding the threshold of each label value separately", func() {
+		spec := &obs.ThrottleFilterSpec{
+			Key:           `{.kubernetes.labels.tenant||"none"}`,
+			Threshold:     3,
+			WindowSeconds: 60,
+			Action:        obs.ThrottleActionTag,
+		}
+		out := throttled(spec, tenantRecords(5, "red", "blue"))
+		Expect(countByTenant(out, false)).To(Equal(map[string]int{"red": 3, "blue": 3}))
+		Expect(countByTenant(out, true)).To(Equal(map[string]int{"red": 2, "blue": 2}))
+	})
+})
@@ -0,0 +1,136 @@
+package otlp
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	otlpInputName  = `otlp-source`
+	servicePortNum = 4318
+
+	timestampNano = 1693227568573159188
+)
+
+// keyValue encodes an opentelemetry.proto.common.v1.KeyValue with a string value
+func keyValue(key, value string) []byte {
+	b := protowire.AppendTag(nil, 1, protowire.BytesType)
+	b = protowire.AppendString(b, key)
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	return protowire.AppendBytes(b, anyValue(value))
+}
+
+// anyValue encodes an opentelemetry.proto.common.v1.AnyValue with a string value
+func anyValue(value string) []byte {
+	b := protowire.AppendTag(nil, 1, protowire.BytesType)
+	return protowire.AppendString(b, value)
+}
+
+func appendMessage(b []byte, num protowire.Number, message []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, message)
+}
+
+// exportLogsServiceRequest encodes an ExportLogsServiceRequest of a single log record using the OTLP protobuf definitions
+func exportLogsServiceRequest(resourceAttributes, attributes map[string]string, severity, body string) []byte {
+	var resource []byte
+	for k, v := range resourceAttributes {
+		resource = appendMessage(resource, 1, keyValue(k, v))
+	}
+	record := protowire.AppendTag(nil, 1, protowire.Fixed64Type)
+	record = protowire.AppendFixed64(record, timestampNano)
+	record = protowire.AppendTag(record, 3, protowire.BytesType)
+	record = protowire.AppendString(record, severity)
+	record = appendMessage(record, 5, anyValue(body))
+	for k, v := range attributes {
+		record = appendMessage(record, 6, keyValue(k, v))
+	}
+	scopeLogs := appendMessage(nil, 2, record)
+	resourceLogs := appendMessage(nil, 1, resource)
+	resourceLogs = appendMessage(resourceLogs, 2, scopeLogs)
+	return appendMessage(nil, 1, resourceLogs)
+}
+
+var _ = Describe("[Functional][Inputs][OTLP] Functional tests", func() {
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	BeforeEach(func() {
+		framework = functional.NewCollectorFunctionalFramework()
+		framework.VisitConfig = func(conf string) string {
+			return strings.Replace(conf, "enabled = true", "enabled = false", 2) // turn off TLS for testing
+		}
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInputName(otlpInputName,
+				func(spec *obs.InputSpec) {
+					spec.Type = obs.InputTypeReceiver
+					spec.Receiver = &obs.ReceiverSpec{
+						Port: servicePortNum,
+						Type: obs.ReceiverTypeOTLP,
+					}
+				}).ToHttpOutput()
+	})
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	Context("When sending an OTLP/HTTP request to an OTLP input", func() {
+		It("should map the log record to the ViaQ data model", func() {
+			Expect(framework.DeployWithVisitor(
+				func(b *runtime.PodBuilder) error {
+					return framework.AddVectorHttpOutput(b, framework.Forwarder.Spec.Outputs[0])
+				}),
+			).To(BeNil())
+
+			request := exportLogsServiceRequest(
+				map[string]string{
+					"k8s.namespace.name": "my-namespace",
+					"k8s.pod.name":       "my-pod",
+					"k8s.container.name": "my-container",
+					"k8s.node.name":      "my-node",
+				},
+				map[string]string{"foo": "bar"},
+				"WARNING",
+				"Hello from an OpenTelemetry SDK",
+			)
+			_, err := framework.RunCommand(constants.CollectorName, "sh", "-c",
+				fmt.Sprintf("echo %s | base64 -d | curl -sf -H 'Content-Type: application/x-protobuf' --data-binary @- http://localhost:%d/v1/logs",
+					base64.StdEncoding.EncodeToString(request), servicePortNum))
+			Expect(err).To(BeNil(), "Expected no errors writing to the OTLP input")
+
+			raw, err := framework.ReadFileFromWithRetryInterval("http", functional.ApplicationLogFile, time.Second)
+			Expect(err).To(BeNil(), "Expected no errors reading the logs")
+			lines := strings.Split(strings.TrimSpace(raw), "\n")
+			Expect(lines).To(HaveLen(1), "--- raw lines:\n%v\n...", raw)
+
+			record := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(lines[0]), &record)).To(Succeed())
+			Expect(record).To(HaveKeyWithValue("message", "Hello from an OpenTelemetry SDK"))
+			Expect(record).To(HaveKeyWithValue("level", "warn"))
+			Expect(record).To(HaveKeyWithValue("log_type", "application"))
+			Expect(record).To(HaveKeyWithValue("hostname", "my-node"))
+			Expect(record).To(HaveKeyWithValue("@timestamp", HavePrefix("2023-08-28T12:59:28.573159188")))
+			Expect(record).To(HaveKeyWithValue("kubernetes", SatisfyAll(
+				HaveKeyWithValue("namespace_name", "my-namespace"),
+				HaveKeyWithValue("pod_name", "my-pod"),
+				HaveKeyWithValue("container_name", "my-container"),
+			)))
+			Expect(record).To(HaveKeyWithValue("otlp", HaveKeyWithValue("attributes", HaveKeyWithValue("foo", "bar"))))
+		})
+	})
+})
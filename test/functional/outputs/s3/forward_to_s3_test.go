@@ -0,0 +1,102 @@
+package s3
+
+import (
+	"time"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	"github.com/openshift/cluster-logging-operator/test/helpers/types"
+	obstestruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("[Functional][Outputs][S3] Forward Output to S3", func() {
+
+	const (
+		logSize   = 1024
+		numOfLogs = 8
+	)
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+		secret    *v1.Secret
+		s3Auth    *obs.CloudwatchAuthentication
+
+		// withSmallBatches flushes a batch for every record instead of waiting for the batch timeout
+		withSmallBatches = func(output *obs.OutputSpec) {
+			output.S3.Tuning = &obs.S3TuningSpec{
+				BaseOutputTuningSpec: obs.BaseOutputTuningSpec{
+					MaxWrite: utils.GetPtr(resource.MustParse("1Ki")),
+				},
+				Compression: "none",
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		framework = functional.NewCollectorFunctionalFramework()
+
+		log.V(2).Info("Creating secret s3 with AWS example credentials")
+		secret = runtime.NewSecret(framework.Namespace, functional.S3Secret,
+			map[string][]byte{
+				constants.AWSAccessKeyID:     []byte(functional.AwsAccessKeyID),
+				constants.AWSSecretAccessKey: []byte(functional.AwsSecretAccessKey),
+			},
+		)
+
+		s3Auth = &obs.CloudwatchAuthentication{
+			Type: obs.CloudwatchAuthTypeAccessKey,
+			AWSAccessKey: &obs.CloudwatchAWSAccessKey{
+				KeySecret: obs.SecretReference{
+					Key:        constants.AWSSecretAccessKey,
+					SecretName: functional.S3Secret,
+				},
+				KeyId: obs.SecretReference{
+					Key:        constants.AWSAccessKeyID,
+					SecretName: functional.S3Secret,
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	DescribeTable("should archive application logs as ndjson under the key prefix", func(keyPrefix, expKeyPrefix string) {
+		obstestruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			ToS3Output(*s3Auth, withSmallBatches, func(output *obs.OutputSpec) {
+				output.S3.KeyPrefix = keyPrefix
+			})
+		framework.Secrets = append(framework.Secrets, secret)
+
+		Expect(framework.Deploy()).To(BeNil())
+
+		Expect(framework.WritesNApplicationLogsOfSize(numOfLogs, logSize, 0)).To(BeNil())
+		time.Sleep(10 * time.Second)
+
+		raw, err := framework.ReadLogsFromS3(expKeyPrefix)
+		Expect(err).To(BeNil())
+		Expect(raw).ToNot(BeEmpty())
+
+		var logs []types.ApplicationLog
+		Expect(types.StrictlyParseLogsFromSlice(raw, &logs)).To(Succeed())
+		for _, l := range logs {
+			Expect(l.LogType).To(Equal(string(obs.InputTypeApplication)))
+			Expect(l.Kubernetes.NamespaceName).To(Equal(framework.Namespace))
+		}
+	},
+		Entry("with the default key prefix", "", "application/"),
+		Entry("with a static + dynamic key prefix", `archive/{.log_type||"none"}/`, "archive/application/"),
+		Entry("with a static + fallback value if field is missing", `archive/{.missing||"none"}/`, "archive/none/"),
+	)
+})
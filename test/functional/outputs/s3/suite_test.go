@@ -0,0 +1,13 @@
+package s3
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFunctionalOutputS3(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[Functional][Outputs][S3] Suite")
+}
@@ -0,0 +1,61 @@
+package transformfailure
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Outputs][TransformFailure]", func() {
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	BeforeEach(func() {
+		framework = functional.NewCollectorFunctionalFramework()
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			ToElasticSearchOutput(func(output *obs.OutputSpec) {
+				// the labels of the pod are an object that can not be converted to a string
+				output.Elasticsearch.Index = `{.kubernetes.labels||"app"}`
+				output.TransformFailureOutputRef = string(obs.OutputTypeHTTP)
+			})
+		framework.Forwarder.Spec.Outputs = append(framework.Forwarder.Spec.Outputs, obs.OutputSpec{
+			Name: string(obs.OutputTypeHTTP),
+			Type: obs.OutputTypeHTTP,
+			HTTP: &obs.HTTP{
+				URLSpec: obs.URLSpec{
+					URL: "http://localhost:8090",
+				},
+				Method: "POST",
+			},
+		})
+	})
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	It("should reroute the events that fail the transforms of an output to its transform failure output", func() {
+		Expect(framework.Deploy()).To(BeNil())
+		Expect(framework.WritesApplicationLogs(1)).To(BeNil())
+
+		raw, err := framework.ReadRawApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(raw).To(HaveLen(1), "Exp. the event to be rerouted to the transform failure output")
+
+		record := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(raw[0]), &record)).To(Succeed())
+		Expect(record).To(HaveKeyWithValue("transform_failure", And(
+			HaveKeyWithValue("output", string(obs.OutputTypeElasticsearch)),
+			HaveKeyWithValue("component_id", ContainSubstring("output_elasticsearch")),
+			HaveKey("message"),
+		)))
+		Expect(record).To(HaveKeyWithValue("log_type", string(obs.InputTypeApplication)))
+	})
+})
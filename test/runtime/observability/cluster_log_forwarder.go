@@ -120,6 +120,22 @@ func (p *PipelineBuilder) WithParseJson() *PipelineBuilder {
 	return p
 }
 
+func (p *PipelineBuilder) WithParseFilter(parseSpec obs.ParseFilterSpec) *PipelineBuilder {
+	p.WithFilter(string(obs.FilterTypeParse), func(spec *obs.FilterSpec) {
+		spec.Type = obs.FilterTypeParse
+		spec.ParseFilterSpec = &parseSpec
+	})
+	return p
+}
+
+func (p *PipelineBuilder) WithRedactFilter(redactSpec obs.RedactFilterSpec) *PipelineBuilder {
+	p.WithFilter(string(obs.FilterTypeRedact), func(spec *obs.FilterSpec) {
+		spec.Type = obs.FilterTypeRedact
+		spec.RedactFilterSpec = &redactSpec
+	})
+	return p
+}
+
 // Named is the name to be given to the ClusterLogForwarder pipeline
 func (p *PipelineBuilder) Named(name string) *PipelineBuilder {
 	p.pipelineName = name
@@ -179,6 +195,23 @@ func (p *PipelineBuilder) ToCloudwatchOutput(auth obs.CloudwatchAuthentication,
 	return p.ToOutputWithVisitor(v, string(obs.OutputTypeCloudwatch))
 }
 
+func (p *PipelineBuilder) ToS3Output(auth obs.CloudwatchAuthentication, visitors ...func(output *obs.OutputSpec)) *ClusterLogForwarderBuilder {
+	v := func(output *obs.OutputSpec) {
+		output.Name = string(obs.OutputTypeS3)
+		output.Type = obs.OutputTypeS3
+		output.S3 = &obs.S3{
+			URL:            "http://localhost:9000",
+			Region:         "us-east-1",
+			Bucket:         "logs",
+			Authentication: &auth,
+		}
+		for _, v := range visitors {
+			v(output)
+		}
+	}
+	return p.ToOutputWithVisitor(v, string(obs.OutputTypeS3))
+}
+
 func (p *PipelineBuilder) ToLokiOutput(lokiURL url.URL, visitors ...func(output *obs.OutputSpec)) *ClusterLogForwarderBuilder {
 	v := func(output *obs.OutputSpec) {
 		output.Name = string(obs.OutputTypeLoki)